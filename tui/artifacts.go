@@ -51,6 +51,13 @@ func buildArtifactCategories(projectPath string) []artifactCategory {
 			Paths: []string{"apps"},
 		},
 	}
+	if paths := discoverTestArtifacts(projectPath); len(paths) > 0 {
+		candidates = append(candidates, artifactCategory{
+			Key:   "tests",
+			Title: "Test Results",
+			Paths: paths,
+		})
+	}
 	var categories []artifactCategory
 	for _, cat := range candidates {
 		desc := summarizeCategory(projectPath, cat.Paths)
@@ -61,9 +68,49 @@ func buildArtifactCategories(projectPath string) []artifactCategory {
 	return categories
 }
 
+var (
+	testArtifactDirs  = []string{"coverage", "test-results"}
+	testArtifactFiles = []string{"junit*.xml"}
+)
+
+// discoverTestArtifacts lists test output and coverage paths in the project
+// root and in each apps/<name> directory, relative to projectPath.
+func discoverTestArtifacts(projectPath string) []string {
+	bases := []string{""}
+	if entries, err := os.ReadDir(filepath.Join(projectPath, "apps")); err == nil {
+		for _, entry := range entries {
+			if entry.IsDir() {
+				bases = append(bases, "apps/"+entry.Name())
+			}
+		}
+	}
+	var paths []string
+	for _, base := range bases {
+		dir := filepath.Join(projectPath, filepath.FromSlash(base))
+		for _, name := range testArtifactDirs {
+			if dirExists(filepath.Join(dir, name)) {
+				paths = append(paths, joinRel(base, name))
+			}
+		}
+		for _, pattern := range testArtifactFiles {
+			matches, _ := filepath.Glob(filepath.Join(dir, pattern))
+			sort.Strings(matches)
+			for _, match := range matches {
+				if info, err := os.Stat(match); err == nil && info.Mode().IsRegular() {
+					paths = append(paths, joinRel(base, filepath.Base(match)))
+				}
+			}
+		}
+	}
+	return paths
+}
+
 func artifactCategoryHasContent(projectPath string, cat artifactCategory) bool {
 	for _, rel := range cat.Paths {
 		abs := filepath.Join(projectPath, filepath.FromSlash(rel))
+		if info, err := os.Stat(abs); err == nil && info.Mode().IsRegular() {
+			return true
+		}
 		entries, err := os.ReadDir(abs)
 		if err != nil {
 			continue
@@ -84,7 +131,18 @@ func summarizeCategory(projectPath string, relPaths []string) string {
 	for _, rel := range relPaths {
 		abs := filepath.Join(projectPath, filepath.FromSlash(rel))
 		info, err := os.Stat(abs)
-		if err != nil || !info.IsDir() {
+		if err != nil {
+			continue
+		}
+		if info.Mode().IsRegular() {
+			exists = true
+			totalItems++
+			if info.ModTime().After(newest) {
+				newest = info.ModTime()
+			}
+			continue
+		}
+		if !info.IsDir() {
 			continue
 		}
 		exists = true
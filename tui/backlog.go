@@ -152,6 +152,9 @@ type backlogEpic struct {
 	StoryCount int
 	TaskCount  int
 	Status     string
+	// Completed and Total count the task rows loaded for the epic.
+	Completed int
+	Total     int
 }
 
 type backlogStory struct {
@@ -181,6 +184,8 @@ type backlogTask struct {
 	UpdatedAt   time.Time
 	LastRun     string
 	Endpoints   string
+	// Dependencies lists the task keys this task depends on.
+	Dependencies []string
 }
 
 type backlogRow struct {
@@ -192,6 +197,8 @@ type backlogRow struct {
 	Status    string
 	Assignee  string
 	UpdatedAt time.Time
+	// Blocked marks a task waiting on a dependency that is not done yet.
+	Blocked bool
 }
 
 func backlogDBPath(projectPath string) string {
@@ -315,7 +322,9 @@ func loadBacklogData(projectPath string) (*backlogData, error) {
 		       COALESCE(acceptance_text, ''),
 		       COALESCE(updated_at, created_at),
 		       COALESCE(last_run, ''),
-		       COALESCE(endpoints, '')
+		       COALESCE(endpoints, ''),
+		       COALESCE(dependencies_json, ''),
+		       COALESCE(dependencies_text, '')
 		  FROM tasks
 		 ORDER BY story_slug, position
 	`)
@@ -323,25 +332,26 @@ func loadBacklogData(projectPath string) (*backlogData, error) {
 		return nil, err
 	}
 	for rows.Next() {
-		var slug, taskID, title, desc, status, assignee, estimate, acceptance, ts, lastRun, endpoints string
+		var slug, taskID, title, desc, status, assignee, estimate, acceptance, ts, lastRun, endpoints, depsJSON, depsText string
 		var position int
-		if err := rows.Scan(&slug, &position, &taskID, &title, &desc, &status, &assignee, &estimate, &acceptance, &ts, &lastRun, &endpoints); err != nil {
+		if err := rows.Scan(&slug, &position, &taskID, &title, &desc, &status, &assignee, &estimate, &acceptance, &ts, &lastRun, &endpoints, &depsJSON, &depsText); err != nil {
 			rows.Close()
 			return nil, err
 		}
 		task := &backlogTask{
-			StorySlug:   strings.TrimSpace(slug),
-			Position:    position,
-			ID:          strings.TrimSpace(taskID),
-			Title:       strings.TrimSpace(title),
-			Description: strings.TrimSpace(desc),
-			Status:      normalizeBacklogStatus(status),
-			Estimate:    strings.TrimSpace(estimate),
-			Assignee:    strings.TrimSpace(assignee),
-			Acceptance:  strings.TrimSpace(acceptance),
-			UpdatedAt:   parseBacklogTime(ts),
-			LastRun:     strings.TrimSpace(lastRun),
-			Endpoints:   strings.TrimSpace(endpoints),
+			StorySlug:    strings.TrimSpace(slug),
+			Position:     position,
+			ID:           strings.TrimSpace(taskID),
+			Title:        strings.TrimSpace(title),
+			Description:  strings.TrimSpace(desc),
+			Status:       normalizeBacklogStatus(status),
+			Estimate:     strings.TrimSpace(estimate),
+			Assignee:     strings.TrimSpace(assignee),
+			Acceptance:   strings.TrimSpace(acceptance),
+			UpdatedAt:    parseBacklogTime(ts),
+			LastRun:      strings.TrimSpace(lastRun),
+			Endpoints:    strings.TrimSpace(endpoints),
+			Dependencies: parseTaskDependencies(depsJSON, depsText),
 		}
 		data.Tasks = append(data.Tasks, task)
 		if story := storyIndex[task.StorySlug]; story != nil {
@@ -361,6 +371,10 @@ func loadBacklogData(projectPath string) (*backlogData, error) {
 			story.Status = aggregateStatus(story.Status, task.Status)
 			if epic := epicIndex[story.EpicKey]; epic != nil {
 				epic.TaskCount++
+				epic.Total++
+				if task.Status == "done" {
+					epic.Completed++
+				}
 				epic.Status = aggregateStatus(epic.Status, task.Status)
 				if task.UpdatedAt.After(epic.UpdatedAt) {
 					epic.UpdatedAt = task.UpdatedAt
@@ -479,6 +493,7 @@ func buildBacklogRows(data *backlogData) []backlogRow {
 					Status:    displayStatus(task.Status),
 					Assignee:  task.Assignee,
 					UpdatedAt: task.UpdatedAt,
+					Blocked:   len(data.OpenDependencies(task)) > 0,
 				}
 				rows = append(rows, taskRow)
 			}
@@ -579,6 +594,21 @@ func displayStatus(status string) string {
 	}
 }
 
+// nextBacklogStatus returns the status after status in the manual cycle
+// todo → doing → blocked → done → todo.
+func nextBacklogStatus(status string) string {
+	switch displayStatus(status) {
+	case "todo":
+		return "doing"
+	case "doing":
+		return "blocked"
+	case "blocked":
+		return "done"
+	default:
+		return "todo"
+	}
+}
+
 func aggregateStatus(existing, incoming string) string {
 	current := normalizeBacklogStatus(existing)
 	next := normalizeBacklogStatus(incoming)
@@ -633,6 +663,80 @@ func (data *backlogData) TaskByNode(node backlogNode) *backlogTask {
 	return nil
 }
 
+// TaskByKey finds a task by its canonical key, ignoring case.
+func (data *backlogData) TaskByKey(key string) *backlogTask {
+	key = strings.TrimSpace(key)
+	if data == nil || key == "" {
+		return nil
+	}
+	for _, task := range data.Tasks {
+		if strings.EqualFold(canonicalTaskKey(task), key) {
+			return task
+		}
+	}
+	return nil
+}
+
+// OpenDependencies returns the dependencies of task that are known tasks not
+// yet done. Keys that match no task are ignored.
+func (data *backlogData) OpenDependencies(task *backlogTask) []string {
+	var open []string
+	for _, key := range task.Dependencies {
+		if dep := data.TaskByKey(key); dep != nil && dep.Status != "done" {
+			open = append(open, key)
+		}
+	}
+	return open
+}
+
+// Dependents returns the keys of tasks that list task as a dependency.
+func (data *backlogData) Dependents(task *backlogTask) []string {
+	key := canonicalTaskKey(task)
+	var keys []string
+	for _, other := range data.Tasks {
+		for _, dep := range other.Dependencies {
+			if strings.EqualFold(dep, key) {
+				keys = append(keys, canonicalTaskKey(other))
+				break
+			}
+		}
+	}
+	return keys
+}
+
+// parseTaskDependencies reads the dependencies_json column, falling back to
+// dependencies_text split on newlines or commas. JSON entries may be plain
+// keys or objects carrying an id.
+func parseTaskDependencies(rawJSON, rawText string) []string {
+	var deps []string
+	var entries []any
+	if err := json.Unmarshal([]byte(strings.TrimSpace(rawJSON)), &entries); err == nil && len(entries) > 0 {
+		for _, entry := range entries {
+			switch v := entry.(type) {
+			case string:
+				deps = append(deps, v)
+			case map[string]any:
+				for _, field := range []string{"task_id", "id", "key"} {
+					if id, ok := v[field].(string); ok {
+						deps = append(deps, id)
+						break
+					}
+				}
+			}
+		}
+	} else {
+		deps = strings.FieldsFunc(rawText, func(r rune) bool { return r == '\n' || r == ',' })
+	}
+	var keys []string
+	for _, dep := range deps {
+		dep = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(dep), "-*"))
+		if dep != "" {
+			keys = append(keys, dep)
+		}
+	}
+	return keys
+}
+
 func (data *backlogData) RowByNode(node backlogNode) (backlogRow, bool) {
 	if data == nil {
 		return backlogRow{}, false
@@ -837,6 +941,140 @@ func exportBacklogCSV(path string, rows []backlogRow) error {
 	return writer.Error()
 }
 
+type backlogExportEpic struct {
+	*backlogEpic
+	Stories []backlogExportStory `json:"stories"`
+}
+
+type backlogExportStory struct {
+	*backlogStory
+	Tasks []*backlogTask `json:"tasks"`
+}
+
+// exportBacklogJSON writes the epics, stories and tasks behind rows as a
+// nested document. Parents of matching rows are kept so the hierarchy stays
+// intact when filters hide them.
+func exportBacklogJSON(path string, data *backlogData, rows []backlogRow) error {
+	if data == nil || len(rows) == 0 {
+		return errors.New("no backlog rows to export")
+	}
+	keep := make(map[backlogNode]bool, len(rows))
+	for _, row := range rows {
+		keep[row.Node] = true
+		keep[backlogNode{Type: backlogNodeEpic, EpicKey: row.Node.EpicKey}] = true
+		if row.Node.StorySlug != "" {
+			keep[backlogNode{Type: backlogNodeStory, EpicKey: row.Node.EpicKey, StorySlug: row.Node.StorySlug}] = true
+		}
+	}
+	epics := []backlogExportEpic{}
+	for _, epic := range data.Epics {
+		if !keep[backlogNode{Type: backlogNodeEpic, EpicKey: epic.Key}] {
+			continue
+		}
+		exportEpic := backlogExportEpic{backlogEpic: epic, Stories: []backlogExportStory{}}
+		for _, story := range data.Stories {
+			if story.EpicKey != epic.Key || !keep[backlogNode{Type: backlogNodeStory, EpicKey: epic.Key, StorySlug: story.Slug}] {
+				continue
+			}
+			exportStory := backlogExportStory{backlogStory: story, Tasks: []*backlogTask{}}
+			for _, task := range data.Tasks {
+				node := backlogNode{Type: backlogNodeTask, EpicKey: epic.Key, StorySlug: story.Slug, TaskPosition: task.Position}
+				if task.StorySlug == story.Slug && keep[node] {
+					exportStory.Tasks = append(exportStory.Tasks, task)
+				}
+			}
+			exportEpic.Stories = append(exportEpic.Stories, exportStory)
+		}
+		epics = append(epics, exportEpic)
+	}
+	payload, err := json.MarshalIndent(map[string]any{
+		"project":     data.ProjectPath,
+		"exported_at": time.Now().UTC().Format(time.RFC3339),
+		"epics":       epics,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(payload, '\n'), 0o644)
+}
+
+// jiraMarkup renders the epic, story or task behind row as Jira wiki markup,
+// with the fields the backlog preview shows and the children of epics and
+// stories as bullet lists.
+func (data *backlogData) jiraMarkup(row backlogRow) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "h2. %s %s\n\n", row.Key, row.Title)
+	switch row.Type {
+	case backlogNodeEpic:
+		epic := data.EpicByKey(row.Node.EpicKey)
+		if epic == nil {
+			return ""
+		}
+		fmt.Fprintf(&b, "*Status:* %s\n", strings.ToUpper(displayStatus(epic.Status)))
+		fmt.Fprintf(&b, "*Progress:* %d/%d tasks complete\n", epic.Completed, epic.Total)
+		var stories []string
+		for _, story := range data.Stories {
+			if story.EpicKey == epic.Key {
+				stories = append(stories, fmt.Sprintf("* %s %s (%s)", canonicalStoryKey(story), safeTitle(story.Title), strings.ToUpper(displayStatus(story.Status))))
+			}
+		}
+		if len(stories) > 0 {
+			sort.Strings(stories)
+			b.WriteString("\nh3. Stories\n")
+			b.WriteString(strings.Join(stories, "\n"))
+			b.WriteRune('\n')
+		}
+	case backlogNodeStory:
+		story := data.StoryBySlug(row.Node.StorySlug)
+		if story == nil {
+			return ""
+		}
+		fmt.Fprintf(&b, "*Status:* %s\n", strings.ToUpper(displayStatus(story.Status)))
+		fmt.Fprintf(&b, "*Progress:* %d/%d tasks complete\n", story.Completed, story.Total)
+		if story.AssigneeHint != "" {
+			fmt.Fprintf(&b, "*Assignee:* %s\n", story.AssigneeHint)
+		}
+		var tasks []*backlogTask
+		for _, task := range data.Tasks {
+			if task.StorySlug == story.Slug {
+				tasks = append(tasks, task)
+			}
+		}
+		sort.Slice(tasks, func(i, j int) bool { return tasks[i].Position < tasks[j].Position })
+		if len(tasks) > 0 {
+			b.WriteString("\nh3. Tasks\n")
+			for _, task := range tasks {
+				fmt.Fprintf(&b, "* %s %s (%s)\n", canonicalTaskKey(task), safeTitle(task.Title), strings.ToUpper(displayStatus(task.Status)))
+			}
+		}
+	case backlogNodeTask:
+		task := data.TaskByNode(row.Node)
+		if task == nil {
+			return ""
+		}
+		fmt.Fprintf(&b, "*Status:* %s\n", strings.ToUpper(displayStatus(task.Status)))
+		if task.Assignee != "" {
+			fmt.Fprintf(&b, "*Assignee:* %s\n", task.Assignee)
+		}
+		if task.Estimate != "" {
+			fmt.Fprintf(&b, "*Estimate:* %s\n", task.Estimate)
+		}
+		if text := strings.TrimSpace(task.Description); text != "" {
+			fmt.Fprintf(&b, "\nh3. Description\n%s\n", text)
+		}
+		if text := strings.TrimSpace(task.Acceptance); text != "" {
+			fmt.Fprintf(&b, "\nh3. Acceptance criteria\n%s\n", text)
+		}
+		if len(task.Dependencies) > 0 {
+			b.WriteString("\nh3. Blocked by\n")
+			for _, key := range task.Dependencies {
+				fmt.Fprintf(&b, "* %s\n", key)
+			}
+		}
+	}
+	return b.String()
+}
+
 func updateTaskStatus(dbPath string, node backlogNode, newStatus string) error {
 	if node.Type != backlogNodeTask {
 		return errors.New("status updates only supported for tasks")
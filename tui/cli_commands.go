@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const cliHelpTimeout = 10 * time.Second
+
+// discoveredCommand is a gpt-creator subcommand parsed from the CLI's help.
+type discoveredCommand struct {
+	Command         []string `json:"command"`
+	Args            string   `json:"args,omitempty"`
+	RequiresProject bool     `json:"requires_project,omitempty"`
+}
+
+// cliCommandCache stores discovered commands with a fingerprint of the CLI
+// binary, so an upgrade invalidates the cache without re-running help.
+type cliCommandCache struct {
+	Binary   string              `json:"binary"`
+	Size     int64               `json:"size"`
+	ModTime  time.Time           `json:"mod_time"`
+	Commands []discoveredCommand `json:"commands"`
+}
+
+type cliCommandsDiscoveredMsg struct {
+	commands []discoveredCommand
+	err      error
+}
+
+// skippedCLICommands are listed by help but make no sense from the palette.
+var skippedCLICommands = map[string]bool{
+	"help":    true,
+	"tui":     true,
+	"version": true,
+}
+
+func cliCommandCachePath() string {
+	return filepath.Join(resolveStateDir(), "cli-commands.json")
+}
+
+// cliBinaryFingerprint resolves the installed gpt-creator and returns the
+// path, size and modification time used to key the cache.
+func cliBinaryFingerprint() (string, int64, time.Time, bool) {
+	bin, err := exec.LookPath("gpt-creator")
+	if err != nil {
+		return "", 0, time.Time{}, false
+	}
+	if resolved, err := filepath.EvalSymlinks(bin); err == nil {
+		bin = resolved
+	}
+	info, err := os.Stat(bin)
+	if err != nil {
+		return "", 0, time.Time{}, false
+	}
+	return bin, info.Size(), info.ModTime(), true
+}
+
+// loadCachedCLICommands returns the cached commands when they were discovered
+// from the binary that is installed now.
+func loadCachedCLICommands() []discoveredCommand {
+	bin, size, modTime, ok := cliBinaryFingerprint()
+	if !ok {
+		return nil
+	}
+	data, err := os.ReadFile(cliCommandCachePath())
+	if err != nil {
+		return nil
+	}
+	var cache cliCommandCache
+	if json.Unmarshal(data, &cache) != nil {
+		return nil
+	}
+	if cache.Binary != bin || cache.Size != size || !cache.ModTime.Equal(modTime) {
+		return nil
+	}
+	return cache.Commands
+}
+
+func saveCachedCLICommands(commands []discoveredCommand) error {
+	bin, size, modTime, ok := cliBinaryFingerprint()
+	if !ok {
+		return nil
+	}
+	data, err := json.MarshalIndent(cliCommandCache{
+		Binary:   bin,
+		Size:     size,
+		ModTime:  modTime,
+		Commands: commands,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := cliCommandCachePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// discoverCLICommands runs `gpt-creator --help` and caches the parsed
+// commands. Subcommands are not asked for their own help: several of them do
+// not recognise --help and would start real work instead.
+func discoverCLICommands() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), cliHelpTimeout)
+		defer cancel()
+		out, err := exec.CommandContext(ctx, "gpt-creator", "--help").Output()
+		if err != nil {
+			return cliCommandsDiscoveredMsg{err: err}
+		}
+		commands := parseCLIHelpCommands(string(out))
+		return cliCommandsDiscoveredMsg{commands: commands, err: saveCachedCLICommands(commands)}
+	}
+}
+
+// parseCLIHelpCommands reads the "gpt-creator <command> [args]" usage lines
+// of the top-level help. A "<a|b>" choice right after the command expands to
+// one entry per choice, and a bare word is kept as a nested subcommand
+// ("dag validate"). Commands with required positional arguments or flags
+// outside brackets are dropped, since the palette cannot supply them, as are
+// deprecated aliases.
+func parseCLIHelpCommands(help string) []discoveredCommand {
+	var commands []discoveredCommand
+	seen := make(map[string]bool)
+	for _, line := range strings.Split(help, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || filepath.Base(fields[0]) != "gpt-creator" || strings.Contains(line, "(deprecated") {
+			continue
+		}
+		name := fields[1]
+		if !isCLICommandWord(name) || skippedCLICommands[name] {
+			continue
+		}
+		rest := fields[2:]
+		variants := [][]string{{name}}
+		if len(rest) > 0 {
+			first := rest[0]
+			switch {
+			case strings.HasPrefix(first, "<") && strings.HasSuffix(first, ">") && strings.Contains(first, "|"):
+				variants = nil
+				for _, choice := range strings.Split(strings.Trim(first, "<>"), "|") {
+					variants = append(variants, []string{name, choice})
+				}
+				rest = rest[1:]
+			case isCLICommandWord(first):
+				variants = [][]string{{name, first}}
+				rest = rest[1:]
+			}
+		}
+		if cliArgsRequired(rest) {
+			continue
+		}
+		args := strings.Join(rest, " ")
+		for _, command := range variants {
+			key := strings.Join(command, " ")
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			commands = append(commands, discoveredCommand{
+				Command:         command,
+				Args:            args,
+				RequiresProject: strings.Contains(args, "--project"),
+			})
+		}
+	}
+	return commands
+}
+
+func isCLICommandWord(word string) bool {
+	if word == "" || strings.HasPrefix(word, "-") {
+		return false
+	}
+	for _, r := range word {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// cliArgsRequired reports whether args contain a placeholder or flag outside
+// square brackets.
+func cliArgsRequired(args []string) bool {
+	depth := 0
+	for _, arg := range args {
+		if depth == 0 && !strings.HasPrefix(arg, "[") && (strings.HasPrefix(arg, "<") || strings.HasPrefix(arg, "-")) {
+			return true
+		}
+		depth += strings.Count(arg, "[") - strings.Count(arg, "]")
+		if depth < 0 {
+			depth = 0
+		}
+	}
+	return false
+}
+
+// discoveredPaletteEntry describes a discovered command for the palette.
+func discoveredPaletteEntry(cmd discoveredCommand) paletteEntry {
+	desc := "Discovered from gpt-creator --help"
+	if cmd.Args != "" {
+		desc += ": " + cmd.Args
+	}
+	return paletteEntry{
+		label:           "gpt-creator " + strings.Join(cmd.Command, " "),
+		command:         cmd.Command,
+		description:     desc,
+		requiresProject: cmd.RequiresProject,
+		meta:            map[string]string{"discovered": "1"},
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bekirdag/gpt-creator/tui/internal/logformat"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// highlighter colors event headers by category. A nil highlighter leaves the
+// output untouched.
+type highlighter struct {
+	rule     lipgloss.Style
+	fallback lipgloss.Style
+	failed   lipgloss.Style
+	byPrefix []categoryStyle
+}
+
+type categoryStyle struct {
+	prefix string
+	style  lipgloss.Style
+}
+
+// newHighlighter resolves the --color mode. An empty mode means auto, except
+// when writing to files where it means never.
+func newHighlighter(mode string, toFile bool) (*highlighter, error) {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	if mode == "" {
+		mode = "auto"
+		if toFile {
+			mode = "never"
+		}
+	}
+	switch mode {
+	case "never":
+		return nil, nil
+	case "auto":
+		if toFile || !isTerminal(os.Stdout) {
+			return nil, nil
+		}
+	case "always":
+	default:
+		return nil, fmt.Errorf("invalid --color %q (want auto, always or never)", mode)
+	}
+
+	renderer := lipgloss.NewRenderer(os.Stdout)
+	if mode == "always" {
+		renderer.SetColorProfile(termenv.ANSI)
+	}
+	header := renderer.NewStyle().Bold(true)
+	return &highlighter{
+		rule:     renderer.NewStyle().Faint(true),
+		fallback: header.Copy(),
+		failed:   header.Copy().Foreground(lipgloss.Color("1")),
+		byPrefix: []categoryStyle{
+			{prefix: "tool.exec", style: header.Copy().Foreground(lipgloss.Color("6"))},
+			{prefix: "tool.patch", style: header.Copy().Foreground(lipgloss.Color("2"))},
+			{prefix: "output.", style: header.Copy().Foreground(lipgloss.Color("2"))},
+			{prefix: "cognition.", style: renderer.NewStyle().Faint(true)},
+			{prefix: "context.", style: header.Copy().Foreground(lipgloss.Color("5"))},
+			{prefix: "telemetry.", style: header.Copy().Foreground(lipgloss.Color("3"))},
+		},
+	}, nil
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// apply colors the header and rules of lines, which must come from
+// logformat.RenderEvent for evt.
+func (h *highlighter) apply(evt logformat.FormattedEvent, lines []string) []string {
+	if h == nil {
+		return lines
+	}
+	style := h.styleFor(evt)
+	for i, line := range lines {
+		switch {
+		case i == 1:
+			lines[i] = style.Render(line)
+		case strings.HasPrefix(line, "------------------") && strings.Trim(line, "-") == "":
+			lines[i] = h.rule.Render(line)
+		}
+	}
+	return lines
+}
+
+func (h *highlighter) styleFor(evt logformat.FormattedEvent) lipgloss.Style {
+	if eventFailed(evt) {
+		return h.failed
+	}
+	for _, candidate := range h.byPrefix {
+		if strings.HasPrefix(evt.Category, candidate.prefix) {
+			return candidate.style
+		}
+	}
+	return h.fallback
+}
+
+func eventFailed(evt logformat.FormattedEvent) bool {
+	for _, attr := range evt.Attributes {
+		if attr.Label != "status" || len(attr.Value) == 0 {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(attr.Value[0])) {
+		case "failed", "failure", "error":
+			return true
+		}
+	}
+	return false
+}
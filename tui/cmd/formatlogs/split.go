@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bekirdag/gpt-creator/tui/internal/logformat"
+)
+
+// writeCategoryFiles renders events into one "<category>.txt" file per
+// category under dir and writes an index.txt summarizing the counts. Blocks
+// are colored only when --color asked for it explicitly.
+func writeCategoryFiles(events []logformat.Event, sourcePath string, store *logformat.ArtifactStore, redactor *logformat.Redactor, highlight *highlighter, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	blocks := make(map[string][]string)
+	counts := make(map[string]int)
+	for _, evt := range events {
+		formatted := redactor.Event(logformat.Format(evt))
+		lines, err := logformat.RenderEvent(formatted, sourcePath, evt.Line, store)
+		if err != nil {
+			return err
+		}
+		category := formatted.Category
+		if category == "" {
+			category = "log.raw"
+		}
+		if counts[category] > 0 {
+			blocks[category] = append(blocks[category], "")
+		}
+		blocks[category] = append(blocks[category], highlight.apply(formatted, lines)...)
+		counts[category]++
+	}
+
+	categories := make([]string, 0, len(counts))
+	for category := range counts {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+
+	width := len("category")
+	for _, category := range categories {
+		if len(category) > width {
+			width = len(category)
+		}
+	}
+	index := []string{
+		fmt.Sprintf("source: %s", sourcePath),
+		fmt.Sprintf("events: %d", len(events)),
+		"",
+		fmt.Sprintf("%-*s  %6s  %s", width, "category", "events", "file"),
+	}
+	used := map[string]bool{"index.txt": true}
+	for _, category := range categories {
+		name := uniqueFileName(categoryFileName(category), used)
+		content := strings.Join(blocks[category], "\n") + "\n"
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			return err
+		}
+		index = append(index, fmt.Sprintf("%-*s  %6d  %s", width, category, counts[category], name))
+	}
+	return os.WriteFile(filepath.Join(dir, "index.txt"), []byte(strings.Join(index, "\n")+"\n"), 0o644)
+}
+
+// uniqueFileName returns name, or name with a numeric suffix when another
+// category already sanitized to the same file, and marks it used.
+func uniqueFileName(name string, used map[string]bool) string {
+	candidate := name
+	ext := filepath.Ext(name)
+	for n := 2; used[candidate]; n++ {
+		candidate = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(name, ext), n, ext)
+	}
+	used[candidate] = true
+	return candidate
+}
+
+func categoryFileName(category string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			return r
+		default:
+			return '-'
+		}
+	}, category)
+	name = strings.Trim(name, ".-_")
+	if name == "" || name == "index" {
+		name = "log.raw"
+	}
+	return name + ".txt"
+}
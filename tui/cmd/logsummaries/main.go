@@ -6,6 +6,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -52,9 +53,15 @@ func main() {
 	var inputPath string
 	var outputPath string
 	var interval int
+	var oneline bool
+	var failOnAnomaly bool
+	var failSeverity string
 	flag.StringVar(&inputPath, "in", "", "input log file path (required)")
 	flag.StringVar(&outputPath, "out", "", "output JSON path (optional, defaults to stdout)")
 	flag.IntVar(&interval, "interval", 5, "number of telemetry events per aggregated snapshot")
+	flag.BoolVar(&oneline, "oneline", false, "print a single summary line instead of JSON")
+	flag.BoolVar(&failOnAnomaly, "fail-on-anomaly", false, "exit with status 2 when the final summary has anomalies")
+	flag.StringVar(&failSeverity, "fail-severity", "warning", "lowest anomaly severity that fails with --fail-on-anomaly: warning or error")
 	flag.Parse()
 
 	if inputPath == "" {
@@ -63,6 +70,10 @@ func main() {
 	if interval <= 0 {
 		exit(errors.New("--interval must be positive"))
 	}
+	threshold, ok := severityRank[strings.ToLower(strings.TrimSpace(failSeverity))]
+	if !ok {
+		exit(fmt.Errorf("invalid --fail-severity %q (want warning or error)", failSeverity))
+	}
 
 	tokens, durations, err := parseTelemetry(inputPath)
 	if err != nil {
@@ -71,18 +82,66 @@ func main() {
 
 	report := buildReport(inputPath, tokens, durations, interval)
 
-	encoded, err := json.MarshalIndent(report, "", "  ")
-	if err != nil {
-		exit(fmt.Errorf("encode report: %w", err))
+	var encoded []byte
+	if oneline {
+		encoded = []byte(formatOneline(report, durations))
+	} else {
+		encoded, err = json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			exit(fmt.Errorf("encode report: %w", err))
+		}
 	}
 
 	if outputPath == "" {
 		fmt.Println(string(encoded))
-		return
-	}
-	if err := os.WriteFile(outputPath, append(encoded, '\n'), 0o644); err != nil {
+	} else if err := os.WriteFile(outputPath, append(encoded, '\n'), 0o644); err != nil {
 		exit(fmt.Errorf("write output: %w", err))
 	}
+
+	if failOnAnomaly {
+		var failing []string
+		for _, anomaly := range report.FinalSummary.Anomalies {
+			severity := anomalySeverity(anomaly)
+			if severityRank[severity] >= threshold {
+				failing = append(failing, fmt.Sprintf("%s: %s", severity, anomaly))
+			}
+		}
+		if len(failing) > 0 {
+			for _, line := range failing {
+				fmt.Fprintf(os.Stderr, "logsummaries: anomaly %s\n", line)
+			}
+			os.Exit(2)
+		}
+	}
+}
+
+var severityRank = map[string]int{
+	"warning": 1,
+	"error":   2,
+}
+
+// anomalySeverity classifies a detectAnomalies message. Negative token deltas
+// point at corrupted telemetry; latency spikes are only warnings.
+func anomalySeverity(anomaly string) string {
+	if strings.HasPrefix(anomaly, "negative token delta") {
+		return "error"
+	}
+	return "warning"
+}
+
+// formatOneline renders the final summary as space-separated key=value pairs
+// for grepping across runs.
+func formatOneline(report telemetryReport, durations []telemetrySnapshot) string {
+	final := report.FinalSummary
+	latency := collectLatency(durations, final.StartTime, final.EndTime)
+	return fmt.Sprintf("run=%s tokens=%d calls=%d p50=%.0f p99=%.0f anomalies=%d",
+		report.RunID,
+		final.TokensTotal,
+		final.LatencyCount,
+		final.LatencyMedian,
+		computePercentile(latency, 99),
+		len(final.Anomalies),
+	)
 }
 
 func exit(err error) {
@@ -306,6 +365,23 @@ func computeMedian(values []int64) float64 {
 	return float64(sorted[mid-1]+sorted[mid]) / 2
 }
 
+// computePercentile returns the nearest-rank percentile p (0-100) of values.
+func computePercentile(values []int64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]int64(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return float64(sorted[rank-1])
+}
+
 func detectAnomalies(tokensDelta int64, latency []int64) []string {
 	var out []string
 	if tokensDelta < 0 {
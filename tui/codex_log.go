@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/bekirdag/gpt-creator/tui/internal/logformat"
+)
+
+const (
+	codexLogPollInterval = 2 * time.Second
+	maxCodexLogFiles     = 12
+	maxCodexLogLines     = 2000
+)
+
+type codexLogTickMsg struct{}
+
+type codexLogFile struct {
+	Rel     string
+	Size    int64
+	ModTime time.Time
+}
+
+// codexLogFiles lists agent transcripts under .gpt-creator/logs, newest first.
+// Structured usage logs (ndjson/json) are skipped.
+func codexLogFiles(projectPath string) []codexLogFile {
+	root := filepath.Join(projectPath, ".gpt-creator", "logs")
+	var files []codexLogFile
+	_ = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if rel, _ := filepath.Rel(root, path); strings.Count(rel, string(filepath.Separator)) >= 1 {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".log", ".txt":
+		default:
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		rel, err := filepath.Rel(projectPath, path)
+		if err != nil {
+			return nil
+		}
+		files = append(files, codexLogFile{Rel: filepath.ToSlash(rel), Size: info.Size(), ModTime: info.ModTime()})
+		return nil
+	})
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime.After(files[j].ModTime)
+	})
+	if len(files) > maxCodexLogFiles {
+		files = files[:maxCodexLogFiles]
+	}
+	return files
+}
+
+func buildCodexLogItems(project *discoveredProject) []featureItemDefinition {
+	if project == nil {
+		return nil
+	}
+	files := codexLogFiles(project.Path)
+	if len(files) == 0 {
+		return []featureItemDefinition{{
+			Key:      "codex-log-empty",
+			Title:    "No Codex logs yet",
+			Desc:     "Run work-on-tasks or a generate command to record a transcript.",
+			Disabled: true,
+		}}
+	}
+	items := make([]featureItemDefinition, 0, len(files))
+	for idx, file := range files {
+		title := filepath.Base(file.Rel)
+		if idx == 0 {
+			title += " (latest)"
+		}
+		items = append(items, featureItemDefinition{
+			Key:   "codex-log-" + sanitizeGenerateKey(file.Rel),
+			Title: title,
+			Desc:  fmt.Sprintf("Updated %s • %s", formatRelativeTime(file.ModTime), formatByteSize(file.Size)),
+			Meta:  map[string]string{"codexLog": file.Rel},
+		})
+	}
+	return items
+}
+
+// renderCodexLogPreview formats a transcript with the formatlogs renderer and
+// keeps the most recent lines.
+func renderCodexLogPreview(project *discoveredProject, item featureItemDefinition) string {
+	if project == nil || item.Meta == nil || item.Meta["codexLog"] == "" {
+		return ""
+	}
+	abs := filepath.Join(project.Path, filepath.FromSlash(item.Meta["codexLog"]))
+	events, err := logformat.ParseFile(abs)
+	if err != nil {
+		return fmt.Sprintf("%s\n\nUnable to read log: %v\n", abs, err)
+	}
+	rendered, err := logformat.Render(events, abs, nil)
+	if err != nil {
+		return fmt.Sprintf("%s\n\nUnable to format log: %v\n", abs, err)
+	}
+	lines := strings.Split(rendered, "\n")
+	if len(lines) > maxCodexLogLines {
+		omitted := len(lines) - maxCodexLogLines
+		lines = append([]string{fmt.Sprintf("… %d earlier line(s) omitted", omitted)}, lines[omitted:]...)
+	}
+	header := fmt.Sprintf("%s\n%d event(s) • following updates every %s\n", abs, len(events), codexLogPollInterval)
+	return header + "\n" + strings.Join(lines, "\n") + "\n"
+}
+
+func codexLogTick() tea.Cmd {
+	return tea.Tick(codexLogPollInterval, func(time.Time) tea.Msg {
+		return codexLogTickMsg{}
+	})
+}
+
+func codexLogStamp(project *discoveredProject, item featureItemDefinition) string {
+	if project == nil || item.Meta == nil || item.Meta["codexLog"] == "" {
+		return ""
+	}
+	info, err := os.Stat(filepath.Join(project.Path, filepath.FromSlash(item.Meta["codexLog"])))
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%d:%d", info.Size(), info.ModTime().UnixNano())
+}
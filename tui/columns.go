@@ -20,6 +20,8 @@ import (
 	"github.com/mattn/go-runewidth"
 	reansi "github.com/muesli/reflow/ansi"
 	"github.com/muesli/reflow/truncate"
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/muesli/reflow/wrap"
 )
 
 const (
@@ -58,6 +60,13 @@ type hoverAwareColumn interface {
 	ClearHover()
 }
 
+// cursorAwareColumn lets the model remember a column's selected row per
+// project and feature and put it back when the user returns.
+type cursorAwareColumn interface {
+	CursorIndex() int
+	SetCursorIndex(index int)
+}
+
 type spacerColumn struct {
 	width  int
 	height int
@@ -160,6 +169,8 @@ type selectableColumn struct {
 	contentOffsetY    int
 	contentPaddingTop int
 	hoverIndex        int
+	pendingG          bool
+	filterRestore     int
 	hoverTitleBase    lipgloss.Style
 	hoverDescBase     lipgloss.Style
 	hoverTitle        lipgloss.Style
@@ -171,17 +182,27 @@ type selectableColumn struct {
 	debugLog          func(format string, args ...interface{})
 	debugLastColWidth int
 	debugLastTitle    int
+	dense             bool
 }
 
 type listEntry struct {
 	title   string
 	desc    string
 	payload any
+	dimmed  bool
 }
 
 func (e listEntry) Title() string       { return e.title }
 func (e listEntry) Description() string { return e.desc }
-func (e listEntry) FilterValue() string { return e.title }
+func (e listEntry) FilterValue() string {
+	if item, ok := e.payload.(workspaceItem); ok {
+		if item.kind != workspaceKindRoot {
+			return ""
+		}
+		return labelForPath(item.path)
+	}
+	return e.title
+}
 
 func newSelectableColumn(title string, items []list.Item, width int, onSelect func(listEntry) tea.Cmd) *selectableColumn {
 	baseDelegate := list.NewDefaultDelegate()
@@ -192,6 +213,7 @@ func newSelectableColumn(title string, items []list.Item, width int, onSelect fu
 		onSelect:       onSelect,
 		activationHint: "Click or Enter to open",
 		hoverIndex:     -1,
+		filterRestore:  -1,
 		// hoverPrefix:    "› ",
 		hoverPrefix: "",
 	}
@@ -212,6 +234,24 @@ func newSelectableColumn(title string, items []list.Item, width int, onSelect fu
 	return column
 }
 
+// SetDense switches between the two-line title/description rows and a
+// single line per item with the description appended after the title.
+func (c *selectableColumn) SetDense(dense bool) {
+	if c.dense == dense || c.delegate == nil {
+		return
+	}
+	c.dense = dense
+	c.delegate.ShowDescription = !dense
+	if dense {
+		c.delegate.SetHeight(1)
+		c.delegate.SetSpacing(0)
+	} else {
+		c.delegate.SetHeight(2)
+		c.delegate.SetSpacing(1)
+	}
+	c.model.SetDelegate(c.delegate)
+}
+
 func (c *selectableColumn) SetDebugLogger(fn func(format string, args ...interface{})) {
 	c.debugLog = fn
 }
@@ -224,6 +264,22 @@ func (c *selectableColumn) SetItems(items []list.Item) {
 	c.hoverIndex = -1
 }
 
+// CursorIndex reports the selected row, or -1 while a filter is applied
+// since the index would then refer to the filtered view.
+func (c *selectableColumn) CursorIndex() int {
+	if len(c.model.Items()) == 0 || c.model.FilterState() != list.Unfiltered {
+		return -1
+	}
+	return c.model.Index()
+}
+
+func (c *selectableColumn) SetCursorIndex(index int) {
+	if index < 0 || index >= len(c.model.Items()) || c.model.FilterState() != list.Unfiltered {
+		return
+	}
+	c.model.Select(index)
+}
+
 func (c *selectableColumn) SetSize(width, height int) {
 	c.width = width
 	if height < 3 {
@@ -334,10 +390,46 @@ func (c *selectableColumn) recalcContentOffsets() {
 
 func (c *selectableColumn) Update(msg tea.Msg) (column, tea.Cmd) {
 	prev := c.model.Index()
+	prevEntry, _ := c.SelectedEntry()
 	switch m := msg.(type) {
 	case tea.KeyMsg:
-		switch m.String() {
+		key := m.String()
+		pendingG := c.pendingG
+		c.pendingG = false
+		switch key {
+		case "/":
+			if c.model.FilteringEnabled() && c.model.FilterState() == list.Unfiltered {
+				c.filterRestore = prev
+			}
+		case "esc":
+			if c.model.FilterState() != list.Unfiltered {
+				return c, c.clearFilter()
+			}
+		}
+		if !c.model.SettingFilter() {
+			switch key {
+			case "g":
+				if !pendingG {
+					c.pendingG = true
+					return c, nil
+				}
+				return c, c.jumpTo(0)
+			case "G":
+				return c, c.jumpTo(len(c.model.VisibleItems()) - 1)
+			}
+		}
+		switch key {
 		case "enter":
+			if c.model.SettingFilter() {
+				var cmd tea.Cmd
+				c.model, cmd = c.model.Update(msg)
+				if c.onSelect != nil {
+					if item, ok := c.model.SelectedItem().(listEntry); ok {
+						return c, tea.Batch(cmd, c.onSelect(item))
+					}
+				}
+				return c, cmd
+			}
 			if c.onSelect != nil {
 				if item, ok := c.model.SelectedItem().(listEntry); ok {
 					return c, c.onSelect(item)
@@ -363,7 +455,11 @@ func (c *selectableColumn) Update(msg tea.Msg) (column, tea.Cmd) {
 	}
 	var cmd tea.Cmd
 	c.model, cmd = c.model.Update(msg)
-	if extra := c.highlightSelection(prev); extra != nil {
+	extra := c.highlightSelection(prev)
+	if extra == nil && c.model.FilterState() != list.Unfiltered {
+		extra = c.highlightChangedEntry(prevEntry)
+	}
+	if extra != nil {
 		if cmd != nil {
 			return c, tea.Batch(cmd, extra)
 		}
@@ -372,6 +468,41 @@ func (c *selectableColumn) Update(msg tea.Msg) (column, tea.Cmd) {
 	return c, cmd
 }
 
+func (c *selectableColumn) clearFilter() tea.Cmd {
+	prevEntry, _ := c.SelectedEntry()
+	restore := c.filterRestore
+	c.filterRestore = -1
+	c.model.ResetFilter()
+	if restore >= 0 && restore < len(c.model.Items()) {
+		c.model.Select(restore)
+	}
+	return c.highlightChangedEntry(prevEntry)
+}
+
+func (c *selectableColumn) highlightChangedEntry(prev listEntry) tea.Cmd {
+	if c.onHighlight == nil {
+		return nil
+	}
+	entry, ok := c.SelectedEntry()
+	if !ok || (entry.title == prev.title && entry.desc == prev.desc) {
+		return nil
+	}
+	return c.onHighlight(entry)
+}
+
+func (c *selectableColumn) FilterActive() bool {
+	return c.model.FilterState() != list.Unfiltered
+}
+
+func (c *selectableColumn) jumpTo(index int) tea.Cmd {
+	if index < 0 || len(c.model.VisibleItems()) == 0 {
+		return nil
+	}
+	prev := c.model.Index()
+	c.model.Select(index)
+	return c.highlightSelection(prev)
+}
+
 func (c *selectableColumn) highlightSelection(prev int) tea.Cmd {
 	if c.onHighlight == nil || c.model.Index() == prev {
 		return nil
@@ -422,6 +553,9 @@ func (c *selectableColumn) HandleMouse(localX, localY int, msg tea.MouseMsg) (co
 	}
 
 	slotHeight := c.delegate.Height() + c.delegate.Spacing() + 1
+	if c.dense {
+		slotHeight = 1
+	}
 	if slotHeight <= 0 {
 		slotHeight = 1
 	}
@@ -472,6 +606,11 @@ func (d *selectableColumnDelegate) Render(w io.Writer, m list.Model, index int,
 
 	title := defaultItem.Title()
 	desc := defaultItem.Description()
+	if d.column != nil && d.column.dense {
+		if first, _, _ := strings.Cut(strings.TrimSpace(desc), "\n"); first != "" {
+			title += " · " + first
+		}
+	}
 
 	width := m.Width()
 	if width <= 0 {
@@ -531,8 +670,10 @@ func (d *selectableColumnDelegate) Render(w io.Writer, m list.Model, index int,
 	emptyFilter := filterState == list.Filtering && m.FilterValue() == ""
 	isFiltered := filterState == list.Filtering || filterState == list.FilterApplied
 
+	entry, _ := item.(listEntry)
+
 	switch {
-	case emptyFilter:
+	case emptyFilter, entry.dimmed && !isSelected && !hovered:
 		title = s.DimmedTitle.Render(title)
 		desc = s.DimmedDesc.Render(desc)
 	case isSelected && filterState != list.Filtering:
@@ -604,8 +745,8 @@ func (c *selectableColumn) View(s styles, focused bool) string {
 			c.debugLastTitle = titleWidth
 		}
 	}
-	title := s.columnTitle.Width(titleWidth).Render(c.title)
 	content := c.model.View()
+	title := s.columnTitle.Width(titleWidth).Render(withScrollIndicators(c.title, c.scrollX, maxLineWidth(content), c.width-panelFrame))
 	leading := countLeadingBlankLines(content)
 	if leading != c.contentPaddingTop {
 		c.contentPaddingTop = leading
@@ -792,6 +933,7 @@ type backlogTreeEntry struct {
 	level    int
 	status   string
 	selected bool
+	blocked  bool
 }
 
 func (e backlogTreeEntry) Title() string {
@@ -811,6 +953,9 @@ func (e backlogTreeEntry) Title() string {
 	if trimmed := strings.TrimSpace(e.status); trimmed != "" {
 		status = fmt.Sprintf(" [%s]", strings.ToUpper(trimmed))
 	}
+	if e.blocked {
+		status += " ⚠"
+	}
 	return fmt.Sprintf("%s%s %s%s", prefix, marker, e.title, status)
 }
 
@@ -836,6 +981,7 @@ type backlogTreeColumn struct {
 	selectedDescBase  lipgloss.Style
 	hasSelectedStyles bool
 	activationHint    string
+	bodyOffset        int
 }
 
 func newBacklogTreeColumn(title string) *backlogTreeColumn {
@@ -911,6 +1057,7 @@ func (c *backlogTreeColumn) ApplyStyles(s styles) {
 		c.updateSelectedWidths()
 	}
 
+	c.bodyOffset = panelBodyOffset(s)
 	c.model.Styles.Title = s.columnTitle.Copy()
 	c.model.Styles.TitleBar = lipgloss.NewStyle()
 	c.model.Styles.Spinner = s.statusHint.Copy().Foreground(crushAccent)
@@ -1019,6 +1166,32 @@ func (c *backlogTreeColumn) Update(msg tea.Msg) (column, tea.Cmd) {
 	return c, tea.Batch(cmds...)
 }
 
+func (c *backlogTreeColumn) HandleMouse(localX, localY int, msg tea.MouseMsg) (column, tea.Cmd) {
+	if msg.Type != tea.MouseLeft {
+		return c, nil
+	}
+	index, ok := listIndexAtLine(c.model, localY-c.bodyOffset, c.delegate.Height())
+	if !ok {
+		return c, nil
+	}
+	prev := c.model.Index()
+	c.model.Select(index)
+	entry, ok := c.selectedEntry()
+	if !ok {
+		return c, nil
+	}
+	if index != prev {
+		if c.onHighlight != nil {
+			return c, c.onHighlight(entry.node)
+		}
+		return c, nil
+	}
+	if c.onActivate != nil {
+		return c, c.onActivate(entry.node)
+	}
+	return c, nil
+}
+
 func (c *backlogTreeColumn) View(s styles, focused bool) string {
 	panel := s.panel
 	bg := crushSurface
@@ -1084,8 +1257,11 @@ type backlogTableColumn struct {
 	width       int
 	height      int
 	rows        []backlogRow
+	bodyOffset  int
 	onHighlight func(backlogRow) tea.Cmd
 	onToggle    func(backlogRow) tea.Cmd
+	epicSummary bool
+	footer      string
 }
 
 func newBacklogTableColumn(title string) *backlogTableColumn {
@@ -1114,6 +1290,7 @@ func (c *backlogTableColumn) ApplyStyles(s styles) {
 		Cell:     s.tableCell,
 		Selected: s.tableActive,
 	})
+	c.bodyOffset = panelBodyOffset(s)
 }
 
 func (c *backlogTableColumn) SetCallbacks(onHighlight, onToggle func(backlogRow) tea.Cmd) {
@@ -1122,6 +1299,7 @@ func (c *backlogTableColumn) SetCallbacks(onHighlight, onToggle func(backlogRow)
 }
 
 func (c *backlogTableColumn) SetRows(rows []backlogRow) {
+	c.setEpicSummary(false)
 	c.rows = rows
 	tableRows := make([]table.Row, len(rows))
 	for i, row := range rows {
@@ -1137,6 +1315,9 @@ func (c *backlogTableColumn) SetRows(rows []backlogRow) {
 			typeLabel = "?"
 		}
 		title := row.Title
+		if row.Blocked {
+			title = "⚠ " + title
+		}
 		if row.Depth > 0 {
 			title = strings.Repeat("  ", row.Depth) + title
 		}
@@ -1157,6 +1338,105 @@ func (c *backlogTableColumn) SetRows(rows []backlogRow) {
 	if len(tableRows) > 0 {
 		c.table.SetCursor(0)
 	}
+	c.footer = backlogRowsFooter(rows)
+	c.resizeTable()
+}
+
+// backlogRowsFooter counts the visible rows by type, with task progress.
+func backlogRowsFooter(rows []backlogRow) string {
+	if len(rows) == 0 {
+		return ""
+	}
+	var epics, stories, tasks, done int
+	for _, row := range rows {
+		switch row.Type {
+		case backlogNodeEpic:
+			epics++
+		case backlogNodeStory:
+			stories++
+		case backlogNodeTask:
+			tasks++
+			if row.Status == "done" {
+				done++
+			}
+		}
+	}
+	return fmt.Sprintf("Σ %d epics • %d stories • %d tasks (%d done)", epics, stories, tasks, done)
+}
+
+// SetEpicRows shows one row per epic with a progress bar and task counts
+// taken from data instead of the full hierarchy.
+func (c *backlogTableColumn) SetEpicRows(rows []backlogRow, data *backlogData) {
+	c.setEpicSummary(true)
+	c.rows = rows
+	tableRows := make([]table.Row, len(rows))
+	completed, total := 0, 0
+	for i, row := range rows {
+		progress, tasks := "", ""
+		if epic := data.EpicByKey(row.Node.EpicKey); epic != nil {
+			progress = textProgressBar(epic.Completed, epic.Total, 10)
+			tasks = fmt.Sprintf("%d/%d", epic.Completed, epic.Total)
+			completed += epic.Completed
+			total += epic.Total
+		}
+		updated := ""
+		if !row.UpdatedAt.IsZero() {
+			updated = formatRelativeTime(row.UpdatedAt)
+		}
+		tableRows[i] = table.Row{
+			row.Key,
+			row.Title,
+			progress,
+			tasks,
+			strings.ToUpper(row.Status),
+			updated,
+		}
+	}
+	c.table.SetRows(tableRows)
+	if len(tableRows) > 0 {
+		c.table.SetCursor(0)
+	}
+	c.footer = ""
+	if len(rows) > 0 {
+		c.footer = fmt.Sprintf("Σ %d epics • %s • %d/%d tasks", len(rows), textProgressBar(completed, total, 10), completed, total)
+	}
+	c.resizeTable()
+}
+
+func (c *backlogTableColumn) setEpicSummary(enabled bool) {
+	if c.epicSummary == enabled {
+		return
+	}
+	c.epicSummary = enabled
+	c.applyColumns()
+}
+
+// textProgressBar renders done/total as plain block characters, which unlike
+// the gradient bar survive table cell truncation.
+func textProgressBar(done, total, width int) string {
+	filled := 0
+	if total > 0 {
+		filled = done * width / total
+	}
+	percent := 0
+	if total > 0 {
+		percent = done * 100 / total
+	}
+	return fmt.Sprintf("%s%s %d%%", strings.Repeat("█", filled), strings.Repeat("░", width-filled), percent)
+}
+
+func (c *backlogTableColumn) CursorIndex() int {
+	if len(c.rows) == 0 {
+		return -1
+	}
+	return c.table.Cursor()
+}
+
+func (c *backlogTableColumn) SetCursorIndex(index int) {
+	if index < 0 || index >= len(c.rows) {
+		return
+	}
+	c.table.SetCursor(index)
 }
 
 func (c *backlogTableColumn) SetSize(width, height int) {
@@ -1168,7 +1448,38 @@ func (c *backlogTableColumn) SetSize(width, height int) {
 	}
 	c.width = width
 	c.height = height
+	c.applyColumns()
+	c.resizeTable()
+}
+
+// resizeTable leaves room for the footer below the table rows.
+func (c *backlogTableColumn) resizeTable() {
+	if c.height == 0 {
+		return
+	}
+	height := c.height - 3
+	if c.footer != "" {
+		height--
+	}
+	c.table.SetHeight(height)
+}
 
+func (c *backlogTableColumn) applyColumns() {
+	width := c.width
+	if width < 30 {
+		width = 30
+	}
+	if c.epicSummary {
+		c.table.SetColumns([]table.Column{
+			{Title: "Key", Width: 12},
+			{Title: "Epic", Width: max(width-50, 16)},
+			{Title: "Progress", Width: 16},
+			{Title: "Tasks", Width: 8},
+			{Title: "Status", Width: 8},
+			{Title: "Updated", Width: 12},
+		})
+		return
+	}
 	colWidths := []int{12, width - 48, 8, 8, 14, 12}
 	if len(colWidths) >= 2 {
 		if colWidths[1] < 20 {
@@ -1183,7 +1494,6 @@ func (c *backlogTableColumn) SetSize(width, height int) {
 		{Title: "Assignee", Width: colWidths[4]},
 		{Title: "Updated", Width: colWidths[5]},
 	})
-	c.table.SetHeight(height - 3)
 }
 
 func (c *backlogTableColumn) selectedRow() (backlogRow, bool) {
@@ -1229,14 +1539,34 @@ func (c *backlogTableColumn) Update(msg tea.Msg) (column, tea.Cmd) {
 	return c, tea.Batch(cmds...)
 }
 
+func (c *backlogTableColumn) HandleMouse(localX, localY int, msg tea.MouseMsg) (column, tea.Cmd) {
+	if msg.Type != tea.MouseLeft {
+		return c, nil
+	}
+	index, ok := tableRowAtLine(c.table, localY-c.bodyOffset)
+	if !ok || index == c.table.Cursor() {
+		return c, nil
+	}
+	c.table.SetCursor(index)
+	if row, ok := c.selectedRow(); ok && c.onHighlight != nil {
+		return c, c.onHighlight(row)
+	}
+	return c, nil
+}
+
 func (c *backlogTableColumn) View(s styles, focused bool) string {
-	body := lipgloss.JoinVertical(lipgloss.Left, s.columnTitle.Render(c.title), c.table.View())
 	panel := s.panel
 	bg := crushSurface
 	if focused {
 		panel = s.panelFocused
 		bg = crushSurfaceElevated
 	}
+	tableView := c.table.View()
+	title := withScrollIndicators(c.title, 0, maxLineWidth(tableView), c.width-panel.GetHorizontalFrameSize())
+	body := lipgloss.JoinVertical(lipgloss.Left, s.columnTitle.Render(title), tableView)
+	if c.footer != "" {
+		body = lipgloss.JoinVertical(lipgloss.Left, body, s.statusHint.Render(c.footer))
+	}
 	return renderPanelWithScroll(panel, c.width, c.height, 0, body, bg, 0)
 }
 
@@ -1333,6 +1663,7 @@ type artifactTreeColumn struct {
 	selectedDescBase  lipgloss.Style
 	hasSelectedStyles bool
 	activationHint    string
+	bodyOffset        int
 }
 
 func newArtifactTreeColumn(title string) *artifactTreeColumn {
@@ -1408,6 +1739,7 @@ func (c *artifactTreeColumn) ApplyStyles(s styles) {
 		c.updateSelectedWidths()
 	}
 
+	c.bodyOffset = panelBodyOffset(s)
 	c.model.Styles.Title = s.columnTitle.Copy()
 	c.model.Styles.TitleBar = lipgloss.NewStyle()
 	c.model.Styles.Spinner = s.statusHint.Copy().Foreground(crushAccent)
@@ -1579,6 +1911,38 @@ func (c *artifactTreeColumn) Update(msg tea.Msg) (column, tea.Cmd) {
 	return c, tea.Batch(cmds...)
 }
 
+func (c *artifactTreeColumn) HandleMouse(localX, localY int, msg tea.MouseMsg) (column, tea.Cmd) {
+	if msg.Type != tea.MouseLeft {
+		return c, nil
+	}
+	index, ok := listIndexAtLine(c.model, localY-c.bodyOffset, c.delegate.Height())
+	if !ok {
+		return c, nil
+	}
+	prev := c.model.Index()
+	c.model.Select(index)
+	entry, ok := c.selectedEntry()
+	if !ok {
+		return c, nil
+	}
+	if index != prev {
+		if c.onHighlight != nil {
+			return c, c.onHighlight(entry.node)
+		}
+		return c, nil
+	}
+	if entry.node.IsDir {
+		if c.onToggle != nil {
+			return c, c.onToggle(entry.node)
+		}
+		return c, nil
+	}
+	if c.onActivate != nil {
+		return c, c.onActivate(entry.node)
+	}
+	return c, nil
+}
+
 func (c *artifactTreeColumn) View(s styles, focused bool) string {
 	panel := s.panel
 	bg := crushSurface
@@ -1627,6 +1991,7 @@ type actionColumn struct {
 	width       int
 	height      int
 	panelFrame  int
+	bodyOffset  int
 	items       []featureItemDefinition
 	selected    map[int]bool
 	onHighlight func(featureItemDefinition, bool) tea.Cmd
@@ -1661,6 +2026,7 @@ func (c *actionColumn) ApplyStyles(s styles) {
 		Selected: s.tableActive,
 	})
 	c.panelFrame = maxInt(s.panel.GetHorizontalFrameSize(), s.panelFocused.GetHorizontalFrameSize())
+	c.bodyOffset = panelBodyOffset(s)
 }
 
 func (c *actionColumn) SetItems(items []featureItemDefinition) {
@@ -1735,6 +2101,20 @@ func (c *actionColumn) ClearSelection() {
 	c.refreshRows()
 }
 
+func (c *actionColumn) CursorIndex() int {
+	if len(c.items) == 0 {
+		return -1
+	}
+	return c.table.Cursor()
+}
+
+func (c *actionColumn) SetCursorIndex(index int) {
+	if index < 0 || index >= len(c.items) {
+		return
+	}
+	c.table.SetCursor(index)
+}
+
 func (c *actionColumn) SetSize(width, height int) {
 	if width < 20 {
 		width = 20
@@ -1811,6 +2191,24 @@ func (c *actionColumn) Update(msg tea.Msg) (column, tea.Cmd) {
 	return c, tea.Batch(cmds...)
 }
 
+func (c *actionColumn) HandleMouse(localX, localY int, msg tea.MouseMsg) (column, tea.Cmd) {
+	if msg.Type != tea.MouseLeft || len(c.items) == 0 {
+		return c, nil
+	}
+	index, ok := tableRowAtLine(c.table, localY-c.bodyOffset)
+	if !ok || index == c.table.Cursor() {
+		return c, nil
+	}
+	c.table.SetCursor(index)
+	if c.onHighlight == nil {
+		return c, nil
+	}
+	if item, ok := c.SelectedItem(); ok {
+		return c, c.onHighlight(item, false)
+	}
+	return c, nil
+}
+
 func (c *actionColumn) View(s styles, focused bool) string {
 	panel := s.panel
 	bg := crushSurface
@@ -1821,13 +2219,13 @@ func (c *actionColumn) View(s styles, focused bool) string {
 	titleFrame := horizontalInnerFrameSize(s.columnTitle)
 	panelFrame := panel.GetHorizontalFrameSize()
 	titleWidth := columnHeaderWidth(c.width, panelFrame, titleFrame)
-	title := s.columnTitle.Width(titleWidth).Render(c.title)
 	var body string
 	if len(c.items) == 0 {
 		body = s.listItem.Copy().Faint(true).Render("No actions available")
 	} else {
 		body = c.table.View()
 	}
+	title := s.columnTitle.Width(titleWidth).Render(withScrollIndicators(c.title, 0, maxLineWidth(body), c.width-panelFrame))
 	inner := lipgloss.JoinVertical(lipgloss.Left, title, body)
 	return renderPanelWithScroll(panel, c.width, c.height, 0, inner, bg, 0)
 }
@@ -2419,6 +2817,7 @@ type envTableColumn struct {
 	width      int
 	height     int
 	panelFrame int
+	bodyOffset int
 	entries    []envEntry
 	reveal     map[string]bool
 	onEdit     func(envEntry) tea.Cmd
@@ -2465,6 +2864,7 @@ func (c *envTableColumn) ApplyStyles(s styles) {
 		Selected: s.tableActive,
 	})
 	c.panelFrame = maxInt(s.panel.GetHorizontalFrameSize(), s.panelFocused.GetHorizontalFrameSize())
+	c.bodyOffset = panelBodyOffset(s)
 }
 
 func (c *envTableColumn) SelectedEntry() (envEntry, bool) {
@@ -2547,6 +2947,20 @@ func (c *envTableColumn) buildRow(entry envEntry) table.Row {
 	}
 }
 
+func (c *envTableColumn) CursorIndex() int {
+	if len(c.entries) == 0 {
+		return -1
+	}
+	return c.table.Cursor()
+}
+
+func (c *envTableColumn) SetCursorIndex(index int) {
+	if index < 0 || index >= len(c.entries) {
+		return
+	}
+	c.table.SetCursor(index)
+}
+
 func (c *envTableColumn) SetSize(width, height int) {
 	if width < 20 {
 		width = 20
@@ -2674,6 +3088,16 @@ func (c *actionColumn) toggleSelection(index int) bool {
 	return true
 }
 
+func (c *envTableColumn) HandleMouse(localX, localY int, msg tea.MouseMsg) (column, tea.Cmd) {
+	if msg.Type != tea.MouseLeft || len(c.entries) == 0 {
+		return c, nil
+	}
+	if index, ok := tableRowAtLine(c.table, localY-c.bodyOffset); ok {
+		c.table.SetCursor(index)
+	}
+	return c, nil
+}
+
 func (c *envTableColumn) View(s styles, focused bool) string {
 	panel := s.panel
 	bg := crushSurface
@@ -2683,13 +3107,14 @@ func (c *envTableColumn) View(s styles, focused bool) string {
 	}
 	titleFrame := horizontalInnerFrameSize(s.columnTitle)
 	panelFrame := panel.GetHorizontalFrameSize()
-	title := s.columnTitle.Width(columnHeaderWidth(c.width, panelFrame, titleFrame)).Render(c.title)
+	titleWidth := columnHeaderWidth(c.width, panelFrame, titleFrame)
 	var body string
 	if len(c.entries) == 0 {
 		body = s.listItem.Copy().Faint(true).Render("No variables detected")
 	} else {
 		body = c.table.View()
 	}
+	title := s.columnTitle.Width(titleWidth).Render(withScrollIndicators(c.title, 0, maxLineWidth(body), c.width-panelFrame))
 	content := lipgloss.JoinVertical(lipgloss.Left, title, body)
 	return renderPanelWithScroll(panel, c.width, c.height, 0, content, bg, 0)
 }
@@ -2741,6 +3166,7 @@ type servicesTableColumn struct {
 	width       int
 	height      int
 	panelFrame  int
+	bodyOffset  int
 	items       []featureItemDefinition
 	onHighlight func(featureItemDefinition, bool) tea.Cmd
 	latencyOK   lipgloss.Style
@@ -2854,6 +3280,20 @@ func (c *servicesTableColumn) SelectedItem() (featureItemDefinition, bool) {
 	return c.items[cursor], true
 }
 
+func (c *servicesTableColumn) CursorIndex() int {
+	if len(c.items) == 0 {
+		return -1
+	}
+	return c.table.Cursor()
+}
+
+func (c *servicesTableColumn) SetCursorIndex(index int) {
+	if index < 0 || index >= len(c.items) {
+		return
+	}
+	c.table.SetCursor(index)
+}
+
 func (c *servicesTableColumn) SetSize(width, height int) {
 	if width < 36 {
 		width = 36
@@ -2918,6 +3358,24 @@ func (c *servicesTableColumn) Update(msg tea.Msg) (column, tea.Cmd) {
 	return c, tea.Batch(cmds...)
 }
 
+func (c *servicesTableColumn) HandleMouse(localX, localY int, msg tea.MouseMsg) (column, tea.Cmd) {
+	if msg.Type != tea.MouseLeft || len(c.items) == 0 {
+		return c, nil
+	}
+	index, ok := tableRowAtLine(c.table, localY-c.bodyOffset)
+	if !ok || index == c.table.Cursor() {
+		return c, nil
+	}
+	c.table.SetCursor(index)
+	if c.onHighlight == nil {
+		return c, nil
+	}
+	if item, ok := c.SelectedItem(); ok {
+		return c, c.onHighlight(item, false)
+	}
+	return c, nil
+}
+
 func (c *servicesTableColumn) View(s styles, focused bool) string {
 	panel := s.panel
 	bg := crushSurface
@@ -2927,13 +3385,14 @@ func (c *servicesTableColumn) View(s styles, focused bool) string {
 	}
 	titleFrame := horizontalInnerFrameSize(s.columnTitle)
 	panelFrame := panel.GetHorizontalFrameSize()
-	title := s.columnTitle.Width(columnHeaderWidth(c.width, panelFrame, titleFrame)).Render(c.title)
+	titleWidth := columnHeaderWidth(c.width, panelFrame, titleFrame)
 	var body string
 	if len(c.items) == 0 {
 		body = s.listItem.Copy().Faint(true).Render("No services detected")
 	} else {
 		body = c.table.View()
 	}
+	title := s.columnTitle.Width(titleWidth).Render(withScrollIndicators(c.title, 0, maxLineWidth(body), c.width-panelFrame))
 	content := lipgloss.JoinVertical(lipgloss.Left, title, body)
 	return renderPanelWithScroll(panel, c.width, c.height, 0, content, bg, 0)
 }
@@ -2974,6 +3433,7 @@ func (c *servicesTableColumn) ApplyStyles(s styles) {
 		Selected: s.tableActive,
 	})
 	c.panelFrame = maxInt(s.panel.GetHorizontalFrameSize(), s.panelFocused.GetHorizontalFrameSize())
+	c.bodyOffset = panelBodyOffset(s)
 	badgeBase := lipgloss.NewStyle().Padding(0, 1)
 	c.latencyOK = badgeBase.Copy().Foreground(crushForeground).Background(crushSurfaceSoft)
 	c.latencySlow = badgeBase.Copy().Foreground(crushForegroundMuted).Background(crushSurfaceSoft)
@@ -2986,10 +3446,12 @@ type tokensTableColumn struct {
 	width       int
 	height      int
 	panelFrame  int
+	bodyOffset  int
 	group       tokensGroupMode
 	rows        []tokensTableRow
 	context     string
 	empty       string
+	footer      string
 	onHighlight func(tokensTableRow) tea.Cmd
 }
 
@@ -3023,6 +3485,21 @@ func (c *tokensTableColumn) ApplyStyles(s styles) {
 		Selected: s.tableActive,
 	})
 	c.panelFrame = maxInt(s.panel.GetHorizontalFrameSize(), s.panelFocused.GetHorizontalFrameSize())
+	c.bodyOffset = panelBodyOffset(s)
+}
+
+func (c *tokensTableColumn) CursorIndex() int {
+	if len(c.rows) == 0 {
+		return -1
+	}
+	return c.table.Cursor()
+}
+
+func (c *tokensTableColumn) SetCursorIndex(index int) {
+	if index < 0 || index >= len(c.rows) {
+		return
+	}
+	c.table.SetCursor(index)
 }
 
 func (c *tokensTableColumn) SetSize(width, height int) {
@@ -3035,7 +3512,19 @@ func (c *tokensTableColumn) SetSize(width, height int) {
 	c.width = width
 	c.height = height
 	c.configureColumns()
-	c.table.SetHeight(height - 3)
+	c.resizeTable()
+}
+
+// resizeTable leaves room for the totals footer below the table rows.
+func (c *tokensTableColumn) resizeTable() {
+	if c.height == 0 {
+		return
+	}
+	height := c.height - 3
+	if c.footer != "" {
+		height--
+	}
+	c.table.SetHeight(height)
 }
 
 func (c *tokensTableColumn) configureColumns() {
@@ -3076,7 +3565,9 @@ func (c *tokensTableColumn) SetPlaceholder(message string) {
 	c.rows = nil
 	c.context = ""
 	c.empty = message
+	c.footer = ""
 	c.table.SetRows(nil)
+	c.resizeTable()
 }
 
 func (c *tokensTableColumn) SetData(rows []tokensTableRow, group tokensGroupMode, context, empty string) {
@@ -3086,6 +3577,8 @@ func (c *tokensTableColumn) SetData(rows []tokensTableRow, group tokensGroupMode
 	c.rows = append([]tokensTableRow(nil), rows...)
 	c.configureColumns()
 	tableRows := make([]table.Row, len(c.rows))
+	var calls, tokens int
+	var cost float64
 	for i, row := range c.rows {
 		tableRows[i] = table.Row{
 			row.Label,
@@ -3094,11 +3587,19 @@ func (c *tokensTableColumn) SetData(rows []tokensTableRow, group tokensGroupMode
 			formatIntComma(row.Tokens),
 			formatCost(row.Cost),
 		}
+		calls += row.Calls
+		tokens += row.Tokens
+		cost += row.Cost
 	}
 	c.table.SetRows(tableRows)
 	if len(tableRows) > 0 {
 		c.table.SetCursor(0)
 	}
+	c.footer = ""
+	if len(c.rows) > 0 {
+		c.footer = fmt.Sprintf("Σ %d rows • %s calls • %s tokens • %s", len(c.rows), formatIntComma(calls), formatIntComma(tokens), formatCost(cost))
+	}
+	c.resizeTable()
 }
 
 func (c *tokensTableColumn) SelectKey(key string) bool {
@@ -3142,29 +3643,55 @@ func (c *tokensTableColumn) Update(msg tea.Msg) (column, tea.Cmd) {
 	return c, nil
 }
 
-func (c *tokensTableColumn) View(s styles, focused bool) string {
-	panel := s.panel
-	bg := crushSurface
-	if focused {
-		panel = s.panelFocused
-		bg = crushSurfaceElevated
+func (c *tokensTableColumn) HandleMouse(localX, localY int, msg tea.MouseMsg) (column, tea.Cmd) {
+	if msg.Type != tea.MouseLeft || len(c.rows) == 0 {
+		return c, nil
 	}
-	titleFrame := horizontalInnerFrameSize(s.columnTitle)
-	panelFrame := panel.GetHorizontalFrameSize()
-	title := s.columnTitle.Width(columnHeaderWidth(c.width, panelFrame, titleFrame)).Render(c.title)
-	var body string
-	if len(c.rows) == 0 {
-		message := strings.TrimSpace(c.empty)
+	line := localY - c.bodyOffset
+	if context := strings.TrimSpace(c.context); context != "" {
+		line -= strings.Count(context, "\n") + 1
+	}
+	index, ok := tableRowAtLine(c.table, line)
+	if !ok || index == c.table.Cursor() {
+		return c, nil
+	}
+	c.table.SetCursor(index)
+	if c.onHighlight == nil {
+		return c, nil
+	}
+	if row, ok := c.SelectedRow(); ok {
+		return c, c.onHighlight(row)
+	}
+	return c, nil
+}
+
+func (c *tokensTableColumn) View(s styles, focused bool) string {
+	panel := s.panel
+	bg := crushSurface
+	if focused {
+		panel = s.panelFocused
+		bg = crushSurfaceElevated
+	}
+	titleFrame := horizontalInnerFrameSize(s.columnTitle)
+	panelFrame := panel.GetHorizontalFrameSize()
+	titleWidth := columnHeaderWidth(c.width, panelFrame, titleFrame)
+	var body string
+	if len(c.rows) == 0 {
+		message := strings.TrimSpace(c.empty)
 		if message == "" {
 			message = "No usage data"
 		}
 		body = s.listItem.Copy().Faint(true).Render(message)
 	} else {
 		body = c.table.View()
+		if c.footer != "" {
+			body = lipgloss.JoinVertical(lipgloss.Left, body, s.statusHint.Render(c.footer))
+		}
 	}
 	if context := strings.TrimSpace(c.context); context != "" {
 		body = lipgloss.JoinVertical(lipgloss.Left, s.statusHint.Render(context), body)
 	}
+	title := s.columnTitle.Width(titleWidth).Render(withScrollIndicators(c.title, 0, maxLineWidth(body), c.width-panelFrame))
 	content := lipgloss.JoinVertical(lipgloss.Left, title, body)
 	return renderPanelWithScroll(panel, c.width, c.height, 0, content, bg, 0)
 }
@@ -3209,6 +3736,7 @@ type reportsTableColumn struct {
 	width        int
 	height       int
 	panelFrame   int
+	bodyOffset   int
 	summaryWidth int
 	rows         []reportTableRow
 	placeholder  string
@@ -3244,6 +3772,21 @@ func (c *reportsTableColumn) ApplyStyles(s styles) {
 		Selected: s.tableActive,
 	})
 	c.panelFrame = maxInt(s.panel.GetHorizontalFrameSize(), s.panelFocused.GetHorizontalFrameSize())
+	c.bodyOffset = panelBodyOffset(s)
+}
+
+func (c *reportsTableColumn) CursorIndex() int {
+	if len(c.rows) == 0 {
+		return -1
+	}
+	return c.table.Cursor()
+}
+
+func (c *reportsTableColumn) SetCursorIndex(index int) {
+	if index < 0 || index >= len(c.rows) {
+		return
+	}
+	c.table.SetCursor(index)
 }
 
 func (c *reportsTableColumn) SetSize(width, height int) {
@@ -3368,6 +3911,24 @@ func (c *reportsTableColumn) Update(msg tea.Msg) (column, tea.Cmd) {
 	return c, tea.Batch(cmds...)
 }
 
+func (c *reportsTableColumn) HandleMouse(localX, localY int, msg tea.MouseMsg) (column, tea.Cmd) {
+	if msg.Type != tea.MouseLeft || len(c.rows) == 0 {
+		return c, nil
+	}
+	index, ok := tableRowAtLine(c.table, localY-c.bodyOffset)
+	if !ok || index == c.table.Cursor() {
+		return c, nil
+	}
+	c.table.SetCursor(index)
+	if c.onHighlight == nil {
+		return c, nil
+	}
+	if entry, ok := c.SelectedEntry(); ok {
+		return c, c.onHighlight(entry, false)
+	}
+	return c, nil
+}
+
 func (c *reportsTableColumn) View(s styles, focused bool) string {
 	panel := s.panel
 	bg := crushSurface
@@ -3377,7 +3938,7 @@ func (c *reportsTableColumn) View(s styles, focused bool) string {
 	}
 	titleFrame := horizontalInnerFrameSize(s.columnTitle)
 	panelFrame := panel.GetHorizontalFrameSize()
-	title := s.columnTitle.Width(columnHeaderWidth(c.width, panelFrame, titleFrame)).Render(c.title)
+	titleWidth := columnHeaderWidth(c.width, panelFrame, titleFrame)
 	var body string
 	if len(c.rows) == 0 {
 		message := c.placeholder
@@ -3388,6 +3949,7 @@ func (c *reportsTableColumn) View(s styles, focused bool) string {
 	} else {
 		body = c.table.View()
 	}
+	title := s.columnTitle.Width(titleWidth).Render(withScrollIndicators(c.title, 0, maxLineWidth(body), c.width-panelFrame))
 	content := lipgloss.JoinVertical(lipgloss.Left, title, body)
 	return renderPanelWithScroll(panel, c.width, c.height, 0, content, bg, 0)
 }
@@ -3449,6 +4011,233 @@ func defaultIfEmpty(value, fallback string) string {
 	return value
 }
 
+type telemetryTableRow struct {
+	event     telemetryEvent
+	timeLabel string
+	details   string
+}
+
+type telemetryTableColumn struct {
+	title        string
+	table        table.Model
+	width        int
+	height       int
+	panelFrame   int
+	bodyOffset   int
+	detailsWidth int
+	rows         []telemetryTableRow
+	placeholder  string
+	onHighlight  func(telemetryEvent) tea.Cmd
+}
+
+func newTelemetryTableColumn(title string) *telemetryTableColumn {
+	columns := []table.Column{
+		{Title: "Time", Width: 15},
+		{Title: "Event", Width: 22},
+		{Title: "Details", Width: 40},
+	}
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithFocused(true),
+		table.WithHeight(10),
+	)
+	return &telemetryTableColumn{
+		title: title,
+		table: t,
+	}
+}
+
+func (c *telemetryTableColumn) SetHighlightFunc(fn func(telemetryEvent) tea.Cmd) {
+	c.onHighlight = fn
+}
+
+func (c *telemetryTableColumn) SetTitle(title string) {
+	c.title = title
+}
+
+func (c *telemetryTableColumn) ApplyStyles(s styles) {
+	c.table.SetStyles(table.Styles{
+		Header:   s.tableHeader,
+		Cell:     s.tableCell,
+		Selected: s.tableActive,
+	})
+	c.panelFrame = maxInt(s.panel.GetHorizontalFrameSize(), s.panelFocused.GetHorizontalFrameSize())
+	c.bodyOffset = panelBodyOffset(s)
+}
+
+func (c *telemetryTableColumn) CursorIndex() int {
+	if len(c.rows) == 0 {
+		return -1
+	}
+	return c.table.Cursor()
+}
+
+func (c *telemetryTableColumn) SetCursorIndex(index int) {
+	if index < 0 || index >= len(c.rows) {
+		return
+	}
+	c.table.SetCursor(index)
+}
+
+func (c *telemetryTableColumn) SetSize(width, height int) {
+	if width < 32 {
+		width = 32
+	}
+	if height < 6 {
+		height = 6
+	}
+	c.width = width
+	c.height = height
+	c.configureColumns()
+	c.table.SetHeight(height - 3)
+}
+
+func (c *telemetryTableColumn) configureColumns() {
+	if c.width == 0 {
+		return
+	}
+	timeWidth := 15
+	eventWidth := 22
+	detailsWidth := c.width - timeWidth - eventWidth - 5
+	if detailsWidth < 18 {
+		detailsWidth = 18
+	}
+	c.detailsWidth = detailsWidth
+	c.table.SetColumns([]table.Column{
+		{Title: "Time", Width: timeWidth},
+		{Title: "Event", Width: eventWidth},
+		{Title: "Details", Width: detailsWidth},
+	})
+}
+
+func (c *telemetryTableColumn) SetPlaceholder(message string) {
+	c.rows = nil
+	c.placeholder = strings.TrimSpace(message)
+	c.table.SetRows(nil)
+}
+
+func (c *telemetryTableColumn) SetEvents(events []telemetryEvent) {
+	c.configureColumns()
+	c.rows = make([]telemetryTableRow, len(events))
+	tableRows := make([]table.Row, len(events))
+	for i, event := range events {
+		row := telemetryTableRow{
+			event:     event,
+			timeLabel: formatTelemetryTableTime(event.Timestamp),
+			details:   truncateWidth(defaultIfEmpty(telemetryEventDetails(event), "—"), c.detailsWidth),
+		}
+		c.rows[i] = row
+		tableRows[i] = table.Row{
+			row.timeLabel,
+			event.Event,
+			row.details,
+		}
+	}
+	c.table.SetRows(tableRows)
+	if len(tableRows) > 0 {
+		c.table.SetCursor(0)
+	}
+}
+
+func (c *telemetryTableColumn) SelectedEvent() (telemetryEvent, bool) {
+	if len(c.rows) == 0 {
+		return telemetryEvent{}, false
+	}
+	cursor := c.table.Cursor()
+	if cursor < 0 || cursor >= len(c.rows) {
+		return telemetryEvent{}, false
+	}
+	return c.rows[cursor].event, true
+}
+
+func (c *telemetryTableColumn) Update(msg tea.Msg) (column, tea.Cmd) {
+	prev := c.table.Cursor()
+	var cmd tea.Cmd
+	c.table, cmd = c.table.Update(msg)
+	if c.table.Cursor() != prev && c.onHighlight != nil {
+		if event, ok := c.SelectedEvent(); ok {
+			return c, tea.Batch(cmd, c.onHighlight(event))
+		}
+	}
+	return c, cmd
+}
+
+func (c *telemetryTableColumn) HandleMouse(localX, localY int, msg tea.MouseMsg) (column, tea.Cmd) {
+	if msg.Type != tea.MouseLeft || len(c.rows) == 0 {
+		return c, nil
+	}
+	index, ok := tableRowAtLine(c.table, localY-c.bodyOffset)
+	if !ok || index == c.table.Cursor() {
+		return c, nil
+	}
+	c.table.SetCursor(index)
+	if c.onHighlight == nil {
+		return c, nil
+	}
+	if event, ok := c.SelectedEvent(); ok {
+		return c, c.onHighlight(event)
+	}
+	return c, nil
+}
+
+func (c *telemetryTableColumn) View(s styles, focused bool) string {
+	panel := s.panel
+	bg := crushSurface
+	if focused {
+		panel = s.panelFocused
+		bg = crushSurfaceElevated
+	}
+	titleFrame := horizontalInnerFrameSize(s.columnTitle)
+	panelFrame := panel.GetHorizontalFrameSize()
+	titleWidth := columnHeaderWidth(c.width, panelFrame, titleFrame)
+	var body string
+	if len(c.rows) == 0 {
+		message := c.placeholder
+		if message == "" {
+			message = "No telemetry events recorded"
+		}
+		body = s.listItem.Copy().Faint(true).Render(message)
+	} else {
+		body = c.table.View()
+	}
+	title := s.columnTitle.Width(titleWidth).Render(withScrollIndicators(c.title, 0, maxLineWidth(body), c.width-panelFrame))
+	content := lipgloss.JoinVertical(lipgloss.Left, title, body)
+	return renderPanelWithScroll(panel, c.width, c.height, 0, content, bg, 0)
+}
+
+func (c *telemetryTableColumn) Title() string {
+	return c.title
+}
+
+func (c *telemetryTableColumn) FocusValue() string {
+	if event, ok := c.SelectedEvent(); ok {
+		return event.Event
+	}
+	return ""
+}
+
+func (c *telemetryTableColumn) ScrollHorizontal(delta int) bool {
+	return false
+}
+
+func (c *telemetryTableColumn) CanMoveDown() bool {
+	if len(c.rows) <= 1 {
+		return false
+	}
+	cursor := c.table.Cursor()
+	if cursor < 0 {
+		return true
+	}
+	return cursor < len(c.rows)-1
+}
+
+func formatTelemetryTableTime(ts time.Time) string {
+	if ts.IsZero() {
+		return "(unknown)"
+	}
+	return ts.Local().Format("02 Jan 15:04:05")
+}
+
 type previewColumn struct {
 	title       string
 	width       int
@@ -3456,7 +4245,23 @@ type previewColumn struct {
 	rawContent  string
 	rendered    string
 	useMarkdown bool
+	raw         bool
+	wrap        bool
+	scrollX     int
 	view        viewport.Model
+
+	findQuery   string
+	findMatches []previewMatch
+	findCurrent int
+	findStyle   lipgloss.Style
+	findActive  lipgloss.Style
+}
+
+// previewMatch locates one find hit in the rendered preview by line and
+// byte offset within the ANSI-stripped line.
+type previewMatch struct {
+	line int
+	col  int
 }
 
 func newPreviewColumn(width int) *previewColumn {
@@ -3471,6 +4276,8 @@ func (p *previewColumn) ApplyStyles(s styles) {
 	p.view.Style = s.body.Copy().
 		Background(crushSurface).
 		ColorWhitespace(true)
+	p.findStyle = lipgloss.NewStyle().Foreground(crushBackground).Background(crushDebug)
+	p.findActive = lipgloss.NewStyle().Foreground(crushBackground).Background(crushAccent).Bold(true)
 }
 
 func (p *previewColumn) SetSize(width, height int) {
@@ -3487,12 +4294,14 @@ func (p *previewColumn) SetSize(width, height int) {
 
 func (p *previewColumn) SetContent(content string) {
 	p.rawContent = content
+	p.scrollX = 0
 	p.useMarkdown = shouldRenderAsMarkdown(content)
 	p.refresh()
 }
 
 func (p *previewColumn) SetMarkdownContent(content string) {
 	p.rawContent = content
+	p.scrollX = 0
 	p.useMarkdown = true
 	p.refresh()
 }
@@ -3512,7 +4321,11 @@ func (p *previewColumn) View(s styles, focused bool) string {
 	}
 	panelFrame := panel.GetHorizontalFrameSize()
 	titleFrame := horizontalInnerFrameSize(s.columnTitle)
-	header := s.columnTitle.Width(columnHeaderWidth(p.width, panelFrame, titleFrame)).Render(p.title)
+	title := p.title
+	if p.findQuery != "" {
+		title = fmt.Sprintf("%s · /%s %s", p.title, p.findQuery, p.FindStatus())
+	}
+	header := s.columnTitle.Width(columnHeaderWidth(p.width, panelFrame, titleFrame)).Render(title)
 	body := lipgloss.JoinVertical(lipgloss.Left, header, p.view.View())
 	return renderPanelWithScroll(panel, p.width, p.height, 0, body, bg, 0)
 }
@@ -3522,25 +4335,304 @@ func (p *previewColumn) Title() string {
 }
 
 func (p *previewColumn) FocusValue() string {
+	if p.findQuery != "" {
+		return "Find " + p.FindStatus()
+	}
 	return ""
 }
 
 func (p *previewColumn) ScrollHorizontal(delta int) bool {
-	return false
+	if delta == 0 || p.wrap {
+		return false
+	}
+	newOffset := p.scrollX + delta
+	if limit := maxLineWidth(p.rendered) - p.view.Width; newOffset > limit {
+		newOffset = limit
+	}
+	if newOffset < 0 {
+		newOffset = 0
+	}
+	if newOffset == p.scrollX {
+		return false
+	}
+	p.scrollX = newOffset
+	p.applyContent()
+	return true
+}
+
+// SetWrap switches between re-flowing content to the column width and
+// keeping long lines intact behind horizontal scrolling.
+func (p *previewColumn) SetWrap(wrap bool) {
+	if p.wrap == wrap {
+		return
+	}
+	p.wrap = wrap
+	p.scrollX = 0
+	p.refresh()
+}
+
+func (p *previewColumn) Wrap() bool {
+	return p.wrap
+}
+
+// SetRaw disables markdown rendering so content is shown as source.
+func (p *previewColumn) SetRaw(raw bool) {
+	if p.raw == raw {
+		return
+	}
+	p.raw = raw
+	p.refresh()
+}
+
+// PlainText returns the rendered preview without ANSI styling or the padding
+// glamour adds to each line.
+func (p *previewColumn) PlainText() string {
+	lines := strings.Split(stripANSI(p.rendered), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+// MarkdownHeadings returns the headings of the current markdown content, or
+// nil when the preview is not showing markdown.
+func (p *previewColumn) MarkdownHeadings() []markdownHeading {
+	if !p.useMarkdown {
+		return nil
+	}
+	return parseMarkdownHeadings(p.rawContent)
+}
+
+// HeadingLine finds the rendered line of headings[index] by matching each
+// heading in turn after the previous one, so repeated titles resolve in
+// order. Only the first few words are compared, in order, because glamour
+// wraps long headings and appends link targets. It returns -1 when the
+// heading cannot be located.
+func (p *previewColumn) HeadingLine(headings []markdownHeading, index int) int {
+	lines := strings.Split(stripANSI(p.rendered), "\n")
+	line := 0
+	for i := 0; i <= index && i < len(headings); i++ {
+		words := strings.Fields(headings[i].Text)
+		if len(words) > 4 {
+			words = words[:4]
+		}
+		found := -1
+		for j := line; j < len(lines) && len(words) > 0; j++ {
+			if containsWordsInOrder(lines[j], words) {
+				found = j
+				break
+			}
+		}
+		if i == index {
+			return found
+		}
+		if found >= 0 {
+			line = found + 1
+		}
+	}
+	return -1
+}
+
+func containsWordsInOrder(line string, words []string) bool {
+	for _, word := range words {
+		idx := strings.Index(line, word)
+		if idx < 0 {
+			return false
+		}
+		line = line[idx+len(word):]
+	}
+	return true
+}
+
+func (p *previewColumn) ScrollToLine(line int) {
+	p.view.SetYOffset(line)
+}
+
+// PageDown scrolls the preview by one viewport height, like a pager's space.
+func (p *previewColumn) PageDown() {
+	p.view.ViewDown()
+}
+
+func (p *previewColumn) PageUp() {
+	p.view.ViewUp()
+}
+
+func (p *previewColumn) AtBottom() bool {
+	return p.view.AtBottom()
+}
+
+func (p *previewColumn) GotoBottom() {
+	p.view.GotoBottom()
 }
 
 func (p *previewColumn) Refresh() {
 	p.refresh()
 }
 
+// SetFind highlights case-insensitive matches of query and scrolls to the
+// first one at or below the current scroll position. It reports whether
+// anything matched; an empty query clears the search.
+func (p *previewColumn) SetFind(query string) bool {
+	p.findQuery = strings.TrimSpace(query)
+	p.findCurrent = 0
+	p.collectFindMatches()
+	if len(p.findMatches) == 0 {
+		p.applyContent()
+		return false
+	}
+	for i, match := range p.findMatches {
+		if match.line >= p.view.YOffset {
+			p.findCurrent = i
+			break
+		}
+	}
+	p.applyContent()
+	p.scrollToFindMatch()
+	return true
+}
+
+func (p *previewColumn) ClearFind() {
+	if p.findQuery == "" {
+		return
+	}
+	p.findQuery = ""
+	p.findMatches = nil
+	p.findCurrent = 0
+	p.applyContent()
+}
+
+func (p *previewColumn) FindActive() bool {
+	return p.findQuery != ""
+}
+
+func (p *previewColumn) FindQuery() string {
+	return p.findQuery
+}
+
+// FindNext moves delta matches forward (or back), wrapping around.
+func (p *previewColumn) FindNext(delta int) bool {
+	if len(p.findMatches) == 0 {
+		return false
+	}
+	n := len(p.findMatches)
+	p.findCurrent = ((p.findCurrent+delta)%n + n) % n
+	p.applyContent()
+	p.scrollToFindMatch()
+	return true
+}
+
+func (p *previewColumn) FindCount() int {
+	return len(p.findMatches)
+}
+
+func (p *previewColumn) FindStatus() string {
+	if len(p.findMatches) == 0 {
+		return "0/0"
+	}
+	return fmt.Sprintf("%d/%d", p.findCurrent+1, len(p.findMatches))
+}
+
+func (p *previewColumn) collectFindMatches() {
+	p.findMatches = nil
+	if p.findQuery == "" {
+		return
+	}
+	lowered := strings.ToLower(p.findQuery)
+	for i, line := range strings.Split(p.rendered, "\n") {
+		plain := stripANSI(line)
+		haystack, needle := strings.ToLower(plain), lowered
+		if len(haystack) != len(plain) || len(needle) != len(p.findQuery) {
+			haystack, needle = plain, p.findQuery
+		}
+		for offset := 0; ; {
+			idx := strings.Index(haystack[offset:], needle)
+			if idx < 0 {
+				break
+			}
+			p.findMatches = append(p.findMatches, previewMatch{line: i, col: offset + idx})
+			offset += idx + len(needle)
+		}
+	}
+}
+
+func (p *previewColumn) scrollToFindMatch() {
+	if p.findCurrent < 0 || p.findCurrent >= len(p.findMatches) {
+		return
+	}
+	line := p.findMatches[p.findCurrent].line
+	if line < p.view.YOffset || line >= p.view.YOffset+p.view.Height {
+		p.view.SetYOffset(maxInt(0, line-p.view.Height/3))
+	}
+}
+
+// highlightFindMatches restyles lines containing matches. Matched lines lose
+// their own styling so highlights do not have to splice ANSI sequences.
+func (p *previewColumn) highlightFindMatches(lines []string) {
+	if len(p.findMatches) == 0 {
+		return
+	}
+	width := len(p.findQuery)
+	byLine := make(map[int][]int)
+	for i, match := range p.findMatches {
+		byLine[match.line] = append(byLine[match.line], i)
+	}
+	for lineIdx, indices := range byLine {
+		if lineIdx >= len(lines) {
+			continue
+		}
+		plain := stripANSI(lines[lineIdx])
+		var b strings.Builder
+		last := 0
+		for _, idx := range indices {
+			match := p.findMatches[idx]
+			end := match.col + width
+			if match.col < last || end > len(plain) {
+				continue
+			}
+			b.WriteString(plain[last:match.col])
+			style := p.findStyle
+			if idx == p.findCurrent {
+				style = p.findActive
+			}
+			b.WriteString(style.Render(plain[match.col:end]))
+			last = end
+		}
+		b.WriteString(plain[last:])
+		lines[lineIdx] = b.String()
+	}
+}
+
 func (p *previewColumn) refresh() {
 	rendered := p.rawContent
-	if p.useMarkdown {
+	if p.useMarkdown && !p.raw {
 		setMarkdownWordWrap(p.view.Width)
 		rendered = RenderMarkdown(p.rawContent)
+	} else if p.wrap && p.view.Width > 0 {
+		rendered = wrap.String(wordwrap.String(rendered, p.view.Width), p.view.Width)
 	}
 	p.rendered = rendered
-	p.view.SetContent(rendered)
+	if p.findQuery != "" {
+		p.collectFindMatches()
+		if p.findCurrent >= len(p.findMatches) {
+			p.findCurrent = 0
+		}
+	}
+	p.applyContent()
+}
+
+func (p *previewColumn) applyContent() {
+	if len(p.findMatches) == 0 && (p.wrap || p.scrollX <= 0) {
+		p.view.SetContent(p.rendered)
+		return
+	}
+	lines := strings.Split(p.rendered, "\n")
+	p.highlightFindMatches(lines)
+	if !p.wrap && p.scrollX > 0 {
+		for i, line := range lines {
+			lines[i] = sliceLineANSI(line, p.scrollX, p.view.Width, "")
+		}
+	}
+	p.view.SetContent(strings.Join(lines, "\n"))
 }
 
 func shouldRenderAsMarkdown(content string) bool {
@@ -3605,7 +4697,10 @@ var featureDefinitions = []featureDefinition{
 	{Key: "verify", Title: "Verify", Desc: "Acceptance & NFR checks"},
 	{Key: "tokens", Title: "Tokens", Desc: "Usage summaries"},
 	{Key: "reports", Title: "Reports", Desc: "Automation reports"},
+	{Key: "codex-log", Title: "Codex log", Desc: "Live agent transcript"},
+	{Key: "telemetry", Title: "Telemetry", Desc: "Recent UI events"},
 	{Key: "env", Title: "Env Editor", Desc: "Environment variables"},
+	{Key: "notes", Title: "Notes", Desc: "Project scratch notes"},
 	{Key: "settings", Title: "Settings", Desc: "Workspace defaults & updates"},
 }
 
@@ -3658,11 +4753,26 @@ var featureItemsByKey = map[string][]featureItemDefinition{
 		{Key: "reports-list", Title: "reports list", Desc: "List generated automation reports", Command: []string{"reports", "list"}, ProjectRequired: true, PreviewKey: "path:reports"},
 		{Key: "reports-backlog", Title: "reports backlog", Desc: "Show pending issue backlog", Command: []string{"reports", "backlog"}, ProjectRequired: true},
 	},
+	"notes": {
+		{Key: "notes-edit", Title: "Edit notes", Desc: "Write " + notesRelPath},
+	},
 	"settings": {
 		{Key: "settings-workspaces", Title: "Workspace roots", Desc: "Configure workspace search paths"},
-		{Key: "settings-theme", Title: "Theme", Desc: "Switch between auto, light, or dark modes"},
+		{Key: "settings-theme", Title: "Theme", Desc: "Switch between auto, light, dark, or high-contrast modes"},
 		{Key: "settings-concurrency", Title: "Concurrency", Desc: "Set max background jobs"},
+		{Key: "settings-services-poll", Title: "Services poll", Desc: "Set services health refresh interval"},
+		{Key: "settings-dashboard-refresh", Title: "Dashboard refresh", Desc: "Set tokens and reports auto-refresh interval"},
+		{Key: "settings-log-lines", Title: "Log history", Desc: "Set how many combined log lines to keep"},
+		{Key: "settings-layout", Title: "Column layout", Desc: "Choose the column order and preview width"},
+		{Key: "settings-features", Title: "Features", Desc: "Choose which features the feature column lists"},
 		{Key: "settings-docker", Title: "Docker path", Desc: "Choose docker CLI binary"},
+		{Key: "settings-telemetry", Title: "Telemetry", Desc: "Toggle local UI event logging"},
+		{Key: "settings-confirm", Title: "Confirmations", Desc: "Require typing yes before destructive commands"},
+		{Key: "settings-dry-run", Title: "Dry run", Desc: "Log commands instead of running them"},
+		{Key: "settings-auto-watch", Title: "Auto watch", Desc: "Rescan the workspace root when directories change"},
+		{Key: "settings-auto-verify", Title: "Auto verify", Desc: "Run verify all after a successful generate"},
+		{Key: "settings-inline-images", Title: "Inline images", Desc: "Show image artifacts in the preview on supported terminals"},
+		{Key: "settings-dense-lists", Title: "Dense lists", Desc: "Show list items on a single line"},
 		{Key: "settings-update", Title: "Update", Desc: "Run gpt-creator update / --force"},
 	},
 	"env": {
@@ -3807,6 +4917,17 @@ func featureItemEntries(project *discoveredProject, featureKey string, dockerAva
 				"verifyTotal":         strconv.Itoa(summary.Stats.Total),
 			},
 		})
+		if failed := failedVerifyChecks(summary); len(failed) > 0 {
+			labels := make([]string, 0, len(failed))
+			for _, check := range failed {
+				labels = append(labels, check.Label)
+			}
+			items = append(items, featureItemDefinition{
+				Key:   "verify-rerun-failed",
+				Title: fmt.Sprintf("%s Re-run failed (%d)", verifyStatusIcon("fail"), len(failed)),
+				Desc:  strings.Join(labels, ", "),
+			})
+		}
 		for _, check := range sortedVerifyChecks(summary) {
 			def, _ := verifyDefinitionByName(check.Name)
 			title := fmt.Sprintf("%s %s", verifyStatusIcon(check.Status), check.Label)
@@ -3863,6 +4984,7 @@ func featureItemEntries(project *discoveredProject, featureKey string, dockerAva
 				Command:         append([]string{}, def.Command...),
 				ProjectRequired: true,
 				PreviewKey:      "verify:check:" + check.Name,
+				LastUpdated:     check.Updated,
 				Meta:            meta,
 			})
 		}
@@ -3872,6 +4994,7 @@ func featureItemEntries(project *discoveredProject, featureKey string, dockerAva
 			switch item.Key {
 			case "verify-acceptance":
 				if check, ok := summary.Checks["acceptance"]; ok {
+					item.LastUpdated = check.Updated
 					actionParts := []string{}
 					actionParts = append(actionParts, verifyStatusLabel(check.Status))
 					if !check.Updated.IsZero() {
@@ -3882,6 +5005,7 @@ func featureItemEntries(project *discoveredProject, featureKey string, dockerAva
 					}
 				}
 			case "verify-all":
+				item.LastUpdated = summary.LastUpdated
 				if summary.LastUpdated.IsZero() {
 					item.Desc = "Run full verification suite"
 				} else {
@@ -3898,6 +5022,9 @@ func featureItemEntries(project *discoveredProject, featureKey string, dockerAva
 				Desc:  summary,
 			})
 		}
+	case "codex-log":
+		appendDefaults = false
+		items = append(items, buildCodexLogItems(project)...)
 	case "reports":
 		if summary := reportsSummary(project); summary != "" {
 			items = append(items, featureItemDefinition{
@@ -3935,6 +5062,9 @@ func featureItemEntries(project *discoveredProject, featureKey string, dockerAva
 			}
 		}
 	}
+	if project != nil {
+		items = loadCommandPolicy(project.Path).filterItems(items)
+	}
 	return items
 }
 
@@ -4048,6 +5178,12 @@ func buildOverviewItems(project *discoveredProject) []featureItemDefinition {
 		})
 	}
 
+	items = append(items, featureItemDefinition{
+		Key:   "overview-export",
+		Title: "Export overview brief",
+		Desc:  "Write a Markdown summary to reports/",
+		Meta:  map[string]string{"overview": "export"},
+	})
 	items = append(items, featureItemDefinition{
 		Key:     "overview-run-create-project",
 		Title:   "Run create-project",
@@ -4116,6 +5252,18 @@ func percentOf(value, total int) int {
 	return (value*100 + total/2) / total
 }
 
+// appendLastRun adds a "last run 2h ago" note to desc when ts is known.
+func appendLastRun(desc string, ts time.Time) string {
+	if ts.IsZero() {
+		return desc
+	}
+	label := formatRelativeTime(ts)
+	if label != "just now" && time.Since(ts) < 7*24*time.Hour {
+		label += " ago"
+	}
+	return desc + " • last run " + label
+}
+
 func formatRelativeTime(ts time.Time) string {
 	if ts.IsZero() {
 		return "N/A"
@@ -4189,6 +5337,8 @@ func renderOverviewPreview(project *discoveredProject, item featureItemDefinitio
 			b.WriteRune('\n')
 		}
 		b.WriteString("Re-run `verify all` to refresh acceptance and NFR checks.\n")
+	case "export":
+		b.WriteString("Combine pipeline progress, backlog summary, verify results and token totals into reports/overview-<timestamp>.md.\nPress Enter in the preview to export.\n")
 	case "action":
 		switch item.Meta["action"] {
 		case "create-project":
@@ -4220,6 +5370,7 @@ func buildGenerateItems(project *discoveredProject) []featureItemDefinition {
 		}}
 	}
 
+	runs := lastGenerateRuns(project.Path)
 	defaults := featureItemsForKey("generate")
 	var allItem featureItemDefinition
 	targetBase := make(map[string]featureItemDefinition)
@@ -4253,6 +5404,8 @@ func buildGenerateItems(project *discoveredProject) []featureItemDefinition {
 		} else {
 			allItem.Desc = "Regenerate all targets"
 		}
+		allItem.LastUpdated = runs[""]
+		allItem.Desc = appendLastRun(allItem.Desc, allItem.LastUpdated)
 		allItem.PreviewKey = "generate:command"
 		items = append(items, allItem)
 	}
@@ -4304,6 +5457,8 @@ func buildGenerateItems(project *discoveredProject) []featureItemDefinition {
 			baseItem.Title = fmt.Sprintf("%s (0)", title)
 			baseItem.Desc = "No pending changes detected"
 		}
+		baseItem.LastUpdated = runs[key]
+		baseItem.Desc = appendLastRun(baseItem.Desc, baseItem.LastUpdated)
 		baseItem.PreviewKey = "generate:target"
 		items = append(items, baseItem)
 
@@ -4374,6 +5529,9 @@ func buildGenerateFileItem(source, targetKey string, change generateFileChange,
 	if change.Status == "renamed" && strings.TrimSpace(change.OldPath) != "" {
 		descParts = append(descParts, fmt.Sprintf("from %s", change.OldPath))
 	}
+	if change.Staged {
+		descParts = append(descParts, "staged")
+	}
 	desc := strings.Join(descParts, " • ")
 	meta := map[string]string{
 		"generateKind":        "file",
@@ -4389,6 +5547,9 @@ func buildGenerateFileItem(source, targetKey string, change generateFileChange,
 	if change.SnapshotOld != "" {
 		meta["generateSnapshotOld"] = change.SnapshotOld
 	}
+	if change.Staged {
+		meta["generateStaged"] = "true"
+	}
 	if warning != "" {
 		meta["generateWarning"] = warning
 	}
@@ -4401,6 +5562,25 @@ func buildGenerateFileItem(source, targetKey string, change generateFileChange,
 	}
 }
 
+// generateFileChangeFromItem rebuilds the change recorded on a generate file
+// item by buildGenerateFileItem.
+func generateFileChangeFromItem(item featureItemDefinition) (generateFileChange, bool) {
+	if item.Meta == nil || item.Meta["generateKind"] != "file" {
+		return generateFileChange{}, false
+	}
+	change := generateFileChange{
+		Path:        strings.TrimSpace(item.Meta["generatePath"]),
+		OldPath:     strings.TrimSpace(item.Meta["generateOldPath"]),
+		Status:      strings.TrimSpace(item.Meta["generateStatus"]),
+		StatusLabel: strings.TrimSpace(item.Meta["generateStatusLabel"]),
+		TargetKey:   strings.TrimSpace(item.Meta["generateTarget"]),
+		DiffSource:  strings.TrimSpace(item.Meta["generateDiffSource"]),
+		SnapshotOld: strings.TrimSpace(item.Meta["generateSnapshotOld"]),
+		Staged:      item.Meta["generateStaged"] == "true",
+	}
+	return change, change.Path != ""
+}
+
 func sanitizeGenerateKey(path string) string {
 	replacer := strings.NewReplacer(
 		" ", "_",
@@ -4489,6 +5669,7 @@ func renderGeneratePreview(project *discoveredProject, item featureItemDefinitio
 		}
 		b.WriteString("\nPress Enter to view a unified diff.\n")
 		b.WriteString("Press `o` to open the file in your editor.\n")
+		b.WriteString("Press `G` to regenerate it (runs its whole target).\n")
 		return b.String()
 	case "warning":
 		return item.Meta["generateWarning"] + "\n"
@@ -4538,6 +5719,9 @@ func itemPreview(project *discoveredProject, featureKey string, item featureItem
 		if stats.VerifyTotal > 0 {
 			fmt.Fprintf(&b, "Verify: %d/%d passing\n", stats.VerifyPass, stats.VerifyTotal)
 		}
+		if stats.GitBranch != "" {
+			fmt.Fprintf(&b, "Git: %s%s\n", stats.GitBranch, ternary(stats.GitDirty, " (uncommitted changes)", ""))
+		}
 		if !project.Stats.LastRun.IsZero() {
 			fmt.Fprintf(&b, "Last activity: %s\n", project.Stats.LastRun.Format(time.RFC822))
 		}
@@ -4558,14 +5742,37 @@ func itemPreview(project *discoveredProject, featureKey string, item featureItem
 		b.WriteString("Provision, import, seed, or export the project database.\n")
 	case "services":
 		b.WriteString("Monitor docker-compose services, container health, and HTTP endpoints.\n")
-		b.WriteString("Shortcuts: u=up • l=logs • d=down • o=open endpoint • 1-9 open specific endpoint.\n")
+		if item.Meta != nil && item.Meta["composeMissing"] == "1" {
+			b.WriteString("\n" + composeMissingMessage + ".\n")
+			b.WriteString("Run actions stay disabled until a compose file exists.\n")
+		} else {
+			if item.Meta != nil && strings.TrimSpace(item.Meta["composePath"]) != "" {
+				b.WriteString("Compose file: " + abbreviatePath(item.Meta["composePath"]) + "\n")
+			}
+			b.WriteString("Shortcuts: u=up • l=logs • d=down • o=open endpoint • a=open all healthy endpoints • 1-9 open specific endpoint.\n")
+		}
 	case "verify":
 		b.WriteString("Run acceptance or full verification suites and inspect their reports.\n")
+		if item.Key == "verify-rerun-failed" {
+			b.WriteString("Press enter to run `gpt-creator verify <name>` for each failing check.\n")
+		}
+		if item.Meta != nil && item.Meta["verifyName"] != "" {
+			b.WriteString("Press `Y` to copy the check details for a ticket.\n")
+		}
+		if project != nil && (item.Meta == nil || item.Meta["verifyName"] == "") {
+			b.WriteString("\n")
+			b.WriteString(renderVerifyDashboard(project))
+		}
 	case "tokens":
 		b.WriteString("Track Codex/OpenAI token usage and costs over time.\n")
 	case "reports":
 		b.WriteString("Browse automation and verify reports, preview details, then open or export entries.\n")
 		b.WriteString("Shortcuts: enter/o open • e export • y copy path.\n")
+	case "codex-log":
+		b.WriteString(renderCodexLogPreview(project, item))
+	case "telemetry":
+		b.WriteString("Tail the local UI event log and filter recent events.\n")
+		b.WriteString("Shortcuts: / filter • c clear filter • r reload • o open log • X clear log.\n")
 	case "settings":
 		b.WriteString(renderSettingsPreview(item))
 	case "env":
@@ -4886,6 +6093,102 @@ func repeatSpaces(n int) []rune {
 	return []rune(strings.Repeat(" ", n))
 }
 
+// panelBodyOffset returns how many lines a panel column draws above its body:
+// the panel's top frame plus the column title.
+func panelBodyOffset(s styles) int {
+	top := maxInt(
+		s.panel.GetBorderTopSize()+s.panel.GetPaddingTop(),
+		s.panelFocused.GetBorderTopSize()+s.panelFocused.GetPaddingTop(),
+	)
+	return top + lipgloss.Height(s.columnTitle.Render(" "))
+}
+
+const rowMarkerPrefix = "@"
+
+func rowMarker(index int) string {
+	return rowMarkerPrefix + strconv.Itoa(index)
+}
+
+// tableRowAtLine reports which row of t is drawn on the given line of
+// t.View(). The table keeps its scroll offset private, so a copy is rendered
+// with index markers in place of the cells and the marker on that line is read back.
+func tableRowAtLine(t table.Model, line int) (int, bool) {
+	rows := t.Rows()
+	if line < 0 || len(rows) == 0 {
+		return -1, false
+	}
+	markers := make([]table.Row, len(rows))
+	for i := range rows {
+		markers[i] = table.Row{rowMarker(i)}
+	}
+	probe := t
+	probe.SetRows(markers)
+	probe.SetColumns([]table.Column{{Width: len(rowMarker(len(rows)))}})
+	return markerAtLine(probe.View(), line, 1)
+}
+
+// listIndexAtLine reports which item of l is drawn on the given line of
+// l.View(), where each item spans up to span lines.
+func listIndexAtLine(l list.Model, line, span int) (int, bool) {
+	items := l.Items()
+	if line < 0 || len(items) == 0 {
+		return -1, false
+	}
+	markers := make([]list.Item, len(items))
+	for i := range items {
+		markers[i] = listEntry{title: rowMarker(i)}
+	}
+	probe := l
+	probe.SetItems(markers)
+	return markerAtLine(probe.View(), line, span)
+}
+
+func markerAtLine(view string, line, span int) (int, bool) {
+	lines := strings.Split(view, "\n")
+	if span < 1 {
+		span = 1
+	}
+	for offset := 0; offset < span; offset++ {
+		idx := line - offset
+		if idx < 0 || idx >= len(lines) {
+			break
+		}
+		for _, field := range strings.Fields(stripANSI(lines[idx])) {
+			if !strings.HasPrefix(field, rowMarkerPrefix) {
+				continue
+			}
+			if index, err := strconv.Atoi(strings.TrimPrefix(field, rowMarkerPrefix)); err == nil {
+				return index, true
+			}
+		}
+	}
+	return -1, false
+}
+
+// maxLineWidth returns the widest line in content, ignoring styling and
+// trailing padding.
+func maxLineWidth(content string) int {
+	widest := 0
+	for _, line := range strings.Split(content, "\n") {
+		if width := lipgloss.Width(strings.TrimRight(stripANSI(line), " ")); width > widest {
+			widest = width
+		}
+	}
+	return widest
+}
+
+// withScrollIndicators marks a column title with ‹ or › when part of the
+// content is scrolled off to the left or extends past the visible width.
+func withScrollIndicators(title string, scrollX, contentWidth, visibleWidth int) string {
+	if scrollX > 0 {
+		title = "‹ " + title
+	}
+	if visibleWidth > 0 && contentWidth-scrollX > visibleWidth {
+		title += " ›"
+	}
+	return title
+}
+
 func renderPanelWithScroll(panel lipgloss.Style, width, height, scrollX int, content string, background lipgloss.Color, fixedLines int) string {
 	if width <= 0 {
 		return ""
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// commandPolicy restricts which commands a project exposes in the UI. It is
+// read from .gpt-creator/ui-commands.json; entries match item keys (run-down),
+// subcommands (run-down, create-project) or top-level commands (update).
+type commandPolicy struct {
+	Allow []string `json:"allow,omitempty"`
+	Deny  []string `json:"deny,omitempty"`
+}
+
+func commandPolicyPath(projectPath string) string {
+	return filepath.Join(projectPath, ".gpt-creator", "ui-commands.json")
+}
+
+type cachedCommandPolicy struct {
+	modTime time.Time
+	size    int64
+	policy  commandPolicy
+}
+
+var (
+	commandPolicyMu    sync.Mutex
+	commandPolicyCache = make(map[string]cachedCommandPolicy)
+)
+
+// loadCommandPolicy returns the project's policy. It is consulted on every
+// item list and palette refresh, so the parsed file is cached until its
+// modification time or size changes.
+func loadCommandPolicy(projectPath string) commandPolicy {
+	if strings.TrimSpace(projectPath) == "" {
+		return commandPolicy{}
+	}
+	path := commandPolicyPath(projectPath)
+	info, err := os.Stat(path)
+	if err != nil {
+		commandPolicyMu.Lock()
+		delete(commandPolicyCache, path)
+		commandPolicyMu.Unlock()
+		return commandPolicy{}
+	}
+	commandPolicyMu.Lock()
+	cached, ok := commandPolicyCache[path]
+	commandPolicyMu.Unlock()
+	if ok && cached.modTime.Equal(info.ModTime()) && cached.size == info.Size() {
+		return cached.policy
+	}
+	policy := readCommandPolicy(path)
+	commandPolicyMu.Lock()
+	commandPolicyCache[path] = cachedCommandPolicy{modTime: info.ModTime(), size: info.Size(), policy: policy}
+	commandPolicyMu.Unlock()
+	return policy
+}
+
+func readCommandPolicy(path string) commandPolicy {
+	var policy commandPolicy
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return policy
+	}
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return commandPolicy{}
+	}
+	policy.Allow = normalizePolicyKeys(policy.Allow)
+	policy.Deny = normalizePolicyKeys(policy.Deny)
+	return policy
+}
+
+func normalizePolicyKeys(keys []string) []string {
+	var out []string
+	for _, key := range keys {
+		if trimmed := strings.ToLower(strings.TrimSpace(key)); trimmed != "" {
+			out = append(out, trimmed)
+		}
+	}
+	return out
+}
+
+func (p commandPolicy) empty() bool {
+	return len(p.Allow) == 0 && len(p.Deny) == 0
+}
+
+// allows reports whether a command may be shown and run. Items without a
+// command are always allowed.
+func (p commandPolicy) allows(itemKey string, command []string) bool {
+	if len(command) == 0 || p.empty() {
+		return true
+	}
+	candidates := []string{strings.ToLower(itemKey), confirmCommandKey(command), strings.ToLower(command[0])}
+	matches := func(keys []string) bool {
+		for _, key := range keys {
+			for _, candidate := range candidates {
+				if candidate != "" && key == candidate {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if matches(p.Deny) {
+		return false
+	}
+	return len(p.Allow) == 0 || matches(p.Allow)
+}
+
+func (p commandPolicy) filterItems(items []featureItemDefinition) []featureItemDefinition {
+	if p.empty() {
+		return items
+	}
+	filtered := make([]featureItemDefinition, 0, len(items))
+	for _, item := range items {
+		if p.allows(item.Key, item.Command) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
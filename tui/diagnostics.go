@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const cliVersionTimeout = 5 * time.Second
+
+type cliVersionMsg struct {
+	version string
+	err     error
+}
+
+// diagnosticsInfo is the read-only environment summary shown by the
+// "About / diagnostics" palette command.
+type diagnosticsInfo struct {
+	cliVersion      string
+	dockerPath      string
+	dockerAvailable bool
+	configDir       string
+	stateDir        string
+	uiConfigPath    string
+	telemetryPath   string
+	workspaceRoot   string
+	projectCount    int
+	currentProject  string
+}
+
+func fetchCLIVersion() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), cliVersionTimeout)
+		defer cancel()
+		out, err := exec.CommandContext(ctx, "gpt-creator", "--version").CombinedOutput()
+		version := strings.TrimSpace(string(out))
+		if idx := strings.IndexByte(version, '\n'); idx >= 0 {
+			version = strings.TrimSpace(version[:idx])
+		}
+		return cliVersionMsg{version: version, err: err}
+	}
+}
+
+// resolveDockerBinary returns the configured docker path or the one found on
+// PATH, without changing any settings.
+func resolveDockerBinary(configured string) string {
+	if path := strings.TrimSpace(configured); path != "" {
+		return path
+	}
+	if path, err := exec.LookPath("docker"); err == nil {
+		return path
+	}
+	return ""
+}
+
+func renderDiagnostics(info diagnosticsInfo) string {
+	dockerStatus := "not found"
+	if info.dockerAvailable {
+		dockerStatus = "available"
+	}
+	rows := [][2]string{
+		{"gpt-creator", fallback(info.cliVersion, "unknown")},
+		{"Docker", fmt.Sprintf("%s (%s)", fallback(info.dockerPath, "docker"), dockerStatus)},
+		{"Config dir", info.configDir},
+		{"State dir", info.stateDir},
+		{"UI config", info.uiConfigPath},
+		{"Telemetry log", info.telemetryPath},
+		{"Workspace root", fallback(info.workspaceRoot, "(none)")},
+		{"Projects", fmt.Sprintf("%d discovered", info.projectCount)},
+		{"Current project", fallback(info.currentProject, "(none)")},
+		{"Platform", fmt.Sprintf("%s/%s, %s", runtime.GOOS, runtime.GOARCH, runtime.Version())},
+	}
+	var b strings.Builder
+	b.WriteString("About / diagnostics\n\n")
+	for _, row := range rows {
+		fmt.Fprintf(&b, "%-16s %s\n", row[0]+":", row[1])
+	}
+	return b.String()
+}
@@ -10,8 +10,11 @@ import (
 	"net/http"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 type composeServiceInfo struct {
@@ -87,6 +90,61 @@ var serviceProbeMap = map[string][]probeSpec{
 	},
 }
 
+const composeMissingMessage = "No docker-compose file found; run `generate compose`"
+
+var composeFileNames = []string{
+	"docker-compose.yml",
+	"docker-compose.yaml",
+	"compose.yml",
+	"compose.yaml",
+}
+
+func findComposeFile(projectDir string) string {
+	if strings.TrimSpace(projectDir) == "" {
+		return ""
+	}
+	for _, dir := range []string{projectDir, filepath.Join(projectDir, "docker")} {
+		for _, name := range composeFileNames {
+			candidate := filepath.Join(dir, name)
+			if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+				return candidate
+			}
+		}
+	}
+	return ""
+}
+
+func annotateComposeItems(items []featureItemDefinition, composePath string) []featureItemDefinition {
+	if composePath != "" {
+		for i := range items {
+			if items[i].Meta == nil {
+				items[i].Meta = map[string]string{}
+			}
+			items[i].Meta["composePath"] = composePath
+		}
+		return items
+	}
+	result := make([]featureItemDefinition, 0, len(items)+1)
+	result = append(result, featureItemDefinition{
+		Key:   "services-compose-missing",
+		Title: "No docker-compose file",
+		Desc:  composeMissingMessage,
+		Meta:  map[string]string{"composeMissing": "1"},
+	})
+	for _, item := range items {
+		switch item.Key {
+		case "services-empty", "services-summary":
+			continue
+		}
+		if len(item.Command) > 0 && item.Command[0] == "run" {
+			item.Disabled = true
+			item.DisabledReason = composeMissingMessage
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
 func gatherServiceItems(project *discoveredProject, dockerAvailable bool) ([]featureItemDefinition, error) {
 	if project == nil {
 		return nil, fmt.Errorf("project required")
@@ -613,3 +671,99 @@ func dockerCLIAvailableWithPath(path string) bool {
 	}
 	return dockerCLIAvailable()
 }
+
+const dockerCheckTimeout = 10 * time.Second
+
+// dockerReadiness is the result of probing whether docker can actually run a
+// stack: the CLI resolves, the daemon answers and the compose plugin exists.
+type dockerReadiness struct {
+	binary         string
+	cliErr         string
+	serverVersion  string
+	daemonErr      string
+	composeVersion string
+	composeErr     string
+}
+
+type dockerReadinessMsg struct {
+	readiness dockerReadiness
+}
+
+func (r dockerReadiness) ready() bool {
+	return r.cliErr == "" && r.daemonErr == "" && r.composeErr == ""
+}
+
+// reason explains the first failing check, or returns "" when docker is
+// ready.
+func (r dockerReadiness) reason() string {
+	switch {
+	case r.cliErr != "":
+		return "Docker CLI not found"
+	case r.daemonErr != "":
+		return "Docker daemon not running"
+	case r.composeErr != "":
+		return "Docker Compose plugin missing"
+	}
+	return ""
+}
+
+// checkDockerReadiness runs `docker info` and `docker compose version` with
+// the configured binary.
+func checkDockerReadiness(configured string) tea.Cmd {
+	return func() tea.Msg {
+		r := dockerReadiness{binary: resolveDockerBinary(configured)}
+		if r.binary == "" || !dockerCLIAvailableWithPath(r.binary) {
+			r.cliErr = "docker executable not found"
+			return dockerReadinessMsg{readiness: r}
+		}
+		run := func(args ...string) (string, string) {
+			ctx, cancel := context.WithTimeout(context.Background(), dockerCheckTimeout)
+			defer cancel()
+			out, err := exec.CommandContext(ctx, r.binary, args...).CombinedOutput()
+			text := strings.TrimSpace(string(out))
+			if err != nil {
+				if text == "" {
+					text = err.Error()
+				}
+				return "", text
+			}
+			return text, ""
+		}
+		r.serverVersion, r.daemonErr = run("info", "--format", "{{.ServerVersion}}")
+		r.composeVersion, r.composeErr = run("compose", "version", "--short")
+		return dockerReadinessMsg{readiness: r}
+	}
+}
+
+func renderDockerReadiness(r dockerReadiness) string {
+	line := func(b *strings.Builder, label, value, errText string) {
+		if errText != "" {
+			first, _, _ := strings.Cut(errText, "\n")
+			fmt.Fprintf(b, "✗ %-8s %s\n", label, first)
+			return
+		}
+		fmt.Fprintf(b, "✓ %-8s %s\n", label, value)
+	}
+	var b strings.Builder
+	b.WriteString("Docker readiness\n────────────────\n\n")
+	line(&b, "CLI", fallback(r.binary, "docker"), r.cliErr)
+	if r.cliErr == "" {
+		line(&b, "Daemon", "server "+r.serverVersion, r.daemonErr)
+		line(&b, "Compose", r.composeVersion, r.composeErr)
+	}
+	b.WriteString("\n")
+	if reason := r.reason(); reason != "" {
+		fmt.Fprintf(&b, "%s; docker-backed actions stay disabled.\n", reason)
+		switch {
+		case r.cliErr != "":
+			b.WriteString("Install Docker Desktop / CLI or set its path in Settings.\n")
+		case r.daemonErr != "":
+			b.WriteString("Start Docker Desktop (or the docker service) and check again.\n")
+		default:
+			b.WriteString("Install the compose plugin (docker compose), then check again.\n")
+		}
+	} else {
+		b.WriteString("Docker is ready to run stacks.\n")
+	}
+	return b.String()
+}
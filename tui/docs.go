@@ -379,6 +379,9 @@ func renderDocsPreview(project *discoveredProject, item featureItemDefinition) s
 			}
 		}
 		builder.WriteString("Press `o` to open in your editor, or Enter to focus the glamour preview.\n")
+		builder.WriteString("Press `M` to switch between rendered and raw markdown.\n")
+		builder.WriteString("Press `D` to diff against git HEAD (or the last generate snapshot), `B` to pick another base.\n")
+		builder.WriteString("Press `T` for a table of contents; moving through it scrolls the preview.\n")
 		return builder.String()
 	}
 	if head := item.Meta["docDiffHead"]; head != "" {
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"crypto/sha256"
+	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
@@ -246,6 +248,60 @@ func (f *envFileState) addEntry(key, value string) int {
 	return len(f.Entries) - 1
 }
 
+// sortEntries orders the entries alphabetically by key. Comment lines directly
+// above an entry move with it; a header block separated from the first entry
+// by a blank line stays at the top and trailing non-entry lines stay at the
+// end. Blank lines between entries are dropped. It reports whether the order
+// changed.
+func (f *envFileState) sortEntries() bool {
+	type envGroup struct {
+		key   string
+		lines []envLine
+	}
+	var header, pending []envLine
+	var groups []envGroup
+	for _, line := range f.Lines {
+		switch line.Kind {
+		case envLineEntry:
+			group := envGroup{key: line.Key}
+			for _, p := range pending {
+				if p.Kind != envLineBlank {
+					group.lines = append(group.lines, p)
+				}
+			}
+			group.lines = append(group.lines, line)
+			groups = append(groups, group)
+			pending = nil
+		case envLineBlank:
+			if len(groups) == 0 {
+				header = append(header, pending...)
+				header = append(header, line)
+				pending = nil
+				continue
+			}
+			pending = append(pending, line)
+		default:
+			pending = append(pending, line)
+		}
+	}
+	if sort.SliceIsSorted(groups, func(i, j int) bool { return groups[i].key < groups[j].key }) {
+		return false
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].key < groups[j].key })
+
+	lines := make([]envLine, 0, len(f.Lines))
+	lines = append(lines, header...)
+	for _, group := range groups {
+		lines = append(lines, group.lines...)
+	}
+	lines = append(lines, pending...)
+	f.Lines = lines
+	f.Dirty = true
+	f.rebuildEntries()
+	f.Validation = f.validate()
+	return true
+}
+
 func (f *envFileState) ensureTrailingNewline() {
 	f.HasTrailingNewline = true
 }
@@ -254,6 +310,101 @@ func (f *envFileState) refreshValidation() {
 	f.Validation = f.validate()
 }
 
+// envConflict is a key defined with different values in more than one env
+// file of the project.
+type envConflict struct {
+	Key    string
+	Values []envConflictValue
+}
+
+type envConflictValue struct {
+	File  string
+	Value string
+}
+
+// findEnvConflicts reports keys whose non-empty values disagree across files.
+// Within a file the last definition wins, matching how the files are loaded.
+func findEnvConflicts(files []*envFileState) []envConflict {
+	byKey := make(map[string][]envConflictValue)
+	for _, state := range files {
+		if state == nil {
+			continue
+		}
+		latest := make(map[string]string)
+		var order []string
+		for _, entry := range state.Entries {
+			if strings.TrimSpace(entry.Value) == "" {
+				continue
+			}
+			if _, seen := latest[entry.Key]; !seen {
+				order = append(order, entry.Key)
+			}
+			latest[entry.Key] = entry.Value
+		}
+		for _, key := range order {
+			byKey[key] = append(byKey[key], envConflictValue{File: state.RelPath, Value: latest[key]})
+		}
+	}
+	var conflicts []envConflict
+	for key, values := range byKey {
+		for _, v := range values[1:] {
+			if v.Value != values[0].Value {
+				conflicts = append(conflicts, envConflict{Key: key, Values: values})
+				break
+			}
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].Key < conflicts[j].Key })
+	return conflicts
+}
+
+// envValueFingerprint masks value but appends a short digest so equal values
+// can be recognised without revealing them.
+func envValueFingerprint(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return fmt.Sprintf("%s (#%x)", maskedSecret(value), sum[:3])
+}
+
+// parseEnvOverrides splits whitespace-separated KEY=VALUE pairs typed for a
+// single job run. A blank value yields no overrides.
+func parseEnvOverrides(value string) ([]string, error) {
+	var overrides []string
+	for _, field := range strings.Fields(value) {
+		eq := strings.IndexByte(field, '=')
+		if eq <= 0 || !isEnvKey(field[:eq]) {
+			return nil, fmt.Errorf("expected KEY=VALUE, got %q", field)
+		}
+		overrides = append(overrides, field)
+	}
+	return overrides, nil
+}
+
+// describeEnvOverrides renders overrides for the log with secret-looking
+// values masked.
+func describeEnvOverrides(overrides []string) string {
+	parts := make([]string, 0, len(overrides))
+	for _, kv := range overrides {
+		key, value, _ := strings.Cut(kv, "=")
+		if isSecretKey(key) {
+			value = maskedSecret(value)
+		}
+		parts = append(parts, key+"="+value)
+	}
+	return strings.Join(parts, " ")
+}
+
+func isEnvKey(key string) bool {
+	for i, r := range key {
+		switch {
+		case r == '_', r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return key != ""
+}
+
 func parseEnvLine(raw string) envLine {
 	trimmed := strings.TrimSpace(raw)
 	if trimmed == "" {
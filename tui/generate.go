@@ -10,6 +10,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -79,6 +80,8 @@ type generateFileChange struct {
 	TargetKey   string
 	DiffSource  string
 	SnapshotOld string
+	// Staged marks a git change that is fully in the index, e.g. after keep.
+	Staged bool
 }
 
 type changeCounts struct {
@@ -215,6 +218,47 @@ func projectHasGitRepo(projectPath string) bool {
 	return strings.TrimSpace(string(out)) == "true"
 }
 
+// gitFileAtRevision returns the contents of rel (relative to projectPath) as
+// recorded at rev.
+func gitFileAtRevision(projectPath, rev, rel string) (string, error) {
+	sha, err := resolveGitRevision(projectPath, rev)
+	if err != nil {
+		return "", err
+	}
+	spec := sha + ":./" + filepath.ToSlash(rel)
+	cmd := exec.Command("git", "-C", projectPath, "--no-pager", "show", spec)
+	out, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+			return "", fmt.Errorf("%s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", err
+	}
+	return string(out), nil
+}
+
+// resolveGitRevision resolves a user-supplied revision to a commit SHA so it
+// can never be parsed as a git option.
+func resolveGitRevision(projectPath, rev string) (string, error) {
+	rev = strings.TrimSpace(rev)
+	if rev == "" {
+		return "", fmt.Errorf("empty revision")
+	}
+	if strings.HasPrefix(rev, "-") {
+		return "", fmt.Errorf("invalid revision %q", rev)
+	}
+	cmd := exec.Command("git", "-C", projectPath, "rev-parse", "--verify", "--quiet", "--end-of-options", rev+"^{commit}")
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("unknown revision %q", rev)
+	}
+	sha := strings.TrimSpace(string(out))
+	if sha == "" {
+		return "", fmt.Errorf("unknown revision %q", rev)
+	}
+	return sha, nil
+}
+
 func unescapeGitPath(path string) string {
 	path = strings.Trim(path, "\"")
 	path = strings.ReplaceAll(path, "\\\\", "\\")
@@ -248,6 +292,7 @@ func buildChangeSetFromGit(projectPath string, changes []gitChange) generateChan
 			StatusLabel: gitStatusLabel(kind, change.XY),
 			TargetKey:   targetKey,
 			DiffSource:  generateDiffSourceGit,
+			Staged:      gitChangeStaged(change.XY),
 		}
 		switch kind {
 		case "added":
@@ -303,6 +348,12 @@ func interpretGitStatus(xy string) string {
 	return ""
 }
 
+// gitChangeStaged reports whether a porcelain XY status has index changes and
+// none left in the work tree.
+func gitChangeStaged(xy string) bool {
+	return len(xy) >= 2 && xy[0] != ' ' && xy[0] != '?' && xy[1] == ' '
+}
+
 func gitStatusLabel(kind, xy string) string {
 	prefix := strings.TrimSpace(xy)
 	if prefix == "" {
@@ -400,6 +451,40 @@ func prepareGenerateSnapshots(projectPath string, targetKeys []string) (snapshot
 	return record, nil
 }
 
+// lastGenerateRuns reads the snapshot directories left by generate jobs and
+// returns when each target last ran. The "" key holds the latest run of any
+// target.
+func lastGenerateRuns(projectPath string) map[string]time.Time {
+	root := filepath.Join(projectPath, ".gpt-creator", "tmp", "generate-snapshots")
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil
+	}
+	runs := make(map[string]time.Time)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		stamp, err := time.Parse("20060102-150405", entry.Name())
+		if err != nil {
+			continue
+		}
+		if stamp.After(runs[""]) {
+			runs[""] = stamp
+		}
+		targets, err := os.ReadDir(filepath.Join(root, entry.Name()))
+		if err != nil {
+			continue
+		}
+		for _, target := range targets {
+			if target.IsDir() && stamp.After(runs[target.Name()]) {
+				runs[target.Name()] = stamp
+			}
+		}
+	}
+	return runs
+}
+
 func snapshotForProject(projectPath string) (snapshotRecord, bool) {
 	globalSnapshotRegistry.mu.Lock()
 	defer globalSnapshotRegistry.mu.Unlock()
@@ -698,6 +783,142 @@ func determineSnapshotChange(rel string, state *snapshotEntryState) *generateFil
 	}
 }
 
+// keepGenerateFile accepts a generated change. Git changes are staged; for
+// snapshot diffs the snapshot copy is updated so the file drops out of review.
+func keepGenerateFile(projectPath string, change generateFileChange) error {
+	rel := filepath.FromSlash(change.Path)
+	if change.DiffSource == generateDiffSourceGit {
+		paths := []string{filepath.ToSlash(rel)}
+		if change.OldPath != "" {
+			paths = append(paths, change.OldPath)
+		}
+		args := append([]string{"-C", projectPath, "add", "-A", "--"}, paths...)
+		return runGitQuiet(args...)
+	}
+	record, ok := snapshotForProject(filepath.Clean(projectPath))
+	if !ok {
+		return errors.New("no generate snapshot available")
+	}
+	base := change.SnapshotOld
+	if base == "" {
+		base = filepath.Join(record.Root, change.TargetKey, rel)
+	}
+	if change.Status == "deleted" {
+		if err := os.Remove(base); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return copyFileExact(currentFileFor(projectPath, change.Path), base)
+}
+
+// revertGenerateFile restores a generated file from its git or snapshot base,
+// deleting it when the generation added it.
+func revertGenerateFile(projectPath string, change generateFileChange) error {
+	cur := currentFileFor(projectPath, change.Path)
+	if change.DiffSource == generateDiffSourceGit {
+		switch {
+		case change.Status == "added":
+			if err := runGitQuiet("-C", projectPath, "rm", "-q", "--cached", "--ignore-unmatch", "--", change.Path); err != nil {
+				return err
+			}
+			if err := os.Remove(cur); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			return nil
+		case change.Status == "renamed" && change.OldPath != "":
+			if err := runGitQuiet("-C", projectPath, "rm", "-q", "-f", "--ignore-unmatch", "--", change.Path); err != nil {
+				return err
+			}
+			if err := os.Remove(cur); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			return runGitQuiet("-C", projectPath, "checkout", "HEAD", "--", change.OldPath)
+		default:
+			return runGitQuiet("-C", projectPath, "checkout", "HEAD", "--", change.Path)
+		}
+	}
+	if change.Status == "added" || change.SnapshotOld == "" {
+		if err := os.Remove(cur); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return copyFileExact(change.SnapshotOld, cur)
+}
+
+func runGitQuiet(args ...string) error {
+	out, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		if msg := strings.TrimSpace(string(out)); msg != "" {
+			return errors.New(msg)
+		}
+		return err
+	}
+	return nil
+}
+
+// generateLineStats totals inserted and removed lines across a target's
+// changed files, using git numstat or the snapshot copies as the base.
+func generateLineStats(projectPath string, files []generateFileChange) (int, int) {
+	added, removed := 0, 0
+	var gitPaths []string
+	for _, change := range files {
+		if change.DiffSource == generateDiffSourceGit {
+			if change.Status == "added" && change.OldPath == "" {
+				added += countFileLines(currentFileFor(projectPath, change.Path))
+				continue
+			}
+			if change.OldPath != "" {
+				gitPaths = append(gitPaths, change.OldPath)
+			}
+			gitPaths = append(gitPaths, change.Path)
+			continue
+		}
+		base := strings.Split(readFileForDiff(change.SnapshotOld), "\n")
+		head := []string{""}
+		if change.Status != "deleted" {
+			head = strings.Split(readFileForDiff(currentFileFor(projectPath, change.Path)), "\n")
+		}
+		for _, chunk := range diffLines(base, head) {
+			switch chunk.op {
+			case diffInsert:
+				added += len(chunk.lines)
+			case diffDelete:
+				removed += len(chunk.lines)
+			}
+		}
+	}
+	if len(gitPaths) > 0 {
+		args := append([]string{"-C", projectPath, "--no-pager", "diff", "HEAD", "--numstat", "--"}, gitPaths...)
+		out, err := exec.Command("git", args...).Output()
+		if err == nil {
+			for _, line := range strings.Split(string(out), "\n") {
+				fields := strings.Fields(line)
+				if len(fields) < 3 {
+					continue
+				}
+				plus, errPlus := strconv.Atoi(fields[0])
+				minus, errMinus := strconv.Atoi(fields[1])
+				if errPlus != nil || errMinus != nil {
+					continue
+				}
+				added += plus
+				removed += minus
+			}
+		}
+	}
+	return added, removed
+}
+
+func countFileLines(path string) int {
+	content := readFileForDiff(path)
+	if content == "" {
+		return 0
+	}
+	return strings.Count(strings.TrimSuffix(content, "\n"), "\n") + 1
+}
+
 func readFileForDiff(path string) string {
 	if path == "" {
 		return ""
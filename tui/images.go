@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// inlineImageProtocol is the terminal graphics protocol the preview can use.
+type inlineImageProtocol int
+
+const (
+	inlineImagesNone inlineImageProtocol = iota
+	inlineImagesKitty
+	inlineImagesITerm
+)
+
+const (
+	maxInlineImageBytes = 4 << 20
+	maxInlineImageCols  = 60
+	kittyChunkSize      = 4096
+	kittyPlaceholder    = '\U0010EEEE'
+)
+
+// kittyRowDiacritics are the first entries of kitty's row/column diacritics;
+// they bound the number of placeholder rows an image may span.
+var kittyRowDiacritics = []rune{
+	0x0305, 0x030D, 0x030E, 0x0310, 0x0312, 0x033D, 0x033E, 0x033F,
+	0x0346, 0x034A, 0x034B, 0x034C, 0x0350, 0x0351, 0x0352, 0x0357,
+	0x035B, 0x0363, 0x0364, 0x0365, 0x0366, 0x0367, 0x0368, 0x0369,
+}
+
+func detectInlineImageProtocol() inlineImageProtocol {
+	term := os.Getenv("TERM")
+	program := os.Getenv("TERM_PROGRAM")
+	switch {
+	case os.Getenv("KITTY_WINDOW_ID") != "" || strings.Contains(term, "kitty"),
+		program == "ghostty", program == "WezTerm":
+		return inlineImagesKitty
+	case program == "iTerm.app":
+		return inlineImagesITerm
+	}
+	return inlineImagesNone
+}
+
+func (p inlineImageProtocol) String() string {
+	switch p {
+	case inlineImagesKitty:
+		return "kitty graphics"
+	case inlineImagesITerm:
+		return "iTerm2 inline images"
+	}
+	return "none"
+}
+
+func isInlineImagePath(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".png", ".jpg", ".jpeg", ".svg":
+		return true
+	}
+	return false
+}
+
+// kittyImage is an image prepared for kitty's Unicode placeholder mode: the
+// transmit sequence uploads the pixels as a virtual placement, and the
+// placeholder text marks the cells the terminal fills with them. Because the
+// placeholders are ordinary text they survive redraws of the preview.
+type kittyImage struct {
+	id          uint32
+	transmit    string
+	placeholder string
+}
+
+// kittyImageID identifies path as previewed at most cols cells wide. It only
+// stats the file, so callers can check their caches before decoding
+// anything; a new modification time or width yields a new id.
+func kittyImageID(path string, cols int) (uint32, error) {
+	if strings.EqualFold(filepath.Ext(path), ".svg") {
+		return 0, fmt.Errorf("SVG images cannot be rasterized for the preview")
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	if info.Size() > maxInlineImageBytes {
+		return 0, fmt.Errorf("image larger than %d MB", maxInlineImageBytes>>20)
+	}
+	cols = min(max(cols, 1), maxInlineImageCols)
+	hash := fnv.New32a()
+	fmt.Fprintf(hash, "%s|%d|%d", path, info.ModTime().UnixNano(), cols)
+	return hash.Sum32()&0xFFFFFF | 1, nil
+}
+
+// prepareKittyImage encodes path under id for a preview at most cols cells
+// wide. JPEGs are re-encoded as PNG, the only compressed format kitty
+// accepts.
+func prepareKittyImage(path string, id uint32, cols int) (kittyImage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return kittyImage{}, err
+	}
+	var img image.Image
+	if bytes.HasPrefix(data, []byte("\x89PNG")) {
+		img, err = png.Decode(bytes.NewReader(data))
+	} else {
+		img, err = jpeg.Decode(bytes.NewReader(data))
+		if err == nil {
+			var buf bytes.Buffer
+			if err = png.Encode(&buf, img); err == nil {
+				data = buf.Bytes()
+			}
+		}
+	}
+	if err != nil {
+		return kittyImage{}, err
+	}
+	bounds := img.Bounds()
+	if bounds.Dx() <= 0 || bounds.Dy() <= 0 {
+		return kittyImage{}, fmt.Errorf("empty image")
+	}
+	cols = min(max(cols, 1), maxInlineImageCols)
+	// Terminal cells are roughly twice as tall as they are wide.
+	rows := (cols*bounds.Dy() + bounds.Dx()) / (2 * bounds.Dx())
+	if rows > len(kittyRowDiacritics) {
+		rows = len(kittyRowDiacritics)
+		cols = max(1, rows*2*bounds.Dx()/bounds.Dy())
+	}
+	rows = max(rows, 1)
+
+	return kittyImage{
+		id:          id,
+		transmit:    kittyTransmitSequence(id, data, cols, rows),
+		placeholder: kittyPlaceholderText(id, cols, rows),
+	}, nil
+}
+
+func kittyTransmitSequence(id uint32, data []byte, cols, rows int) string {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	var b strings.Builder
+	for start := 0; start < len(encoded); start += kittyChunkSize {
+		end := min(start+kittyChunkSize, len(encoded))
+		more := 0
+		if end < len(encoded) {
+			more = 1
+		}
+		if start == 0 {
+			fmt.Fprintf(&b, "\x1b_Ga=T,U=1,q=2,f=100,i=%d,c=%d,r=%d,m=%d;", id, cols, rows, more)
+		} else {
+			fmt.Fprintf(&b, "\x1b_Gm=%d;", more)
+		}
+		b.WriteString(encoded[start:end])
+		b.WriteString("\x1b\\")
+	}
+	return b.String()
+}
+
+// kittyPlaceholderText encodes the image id in the foreground colour and the
+// row in a diacritic on the first cell; the remaining cells of a row inherit
+// it.
+func kittyPlaceholderText(id uint32, cols, rows int) string {
+	color := fmt.Sprintf("\x1b[38;2;%d;%d;%dm", id>>16&0xFF, id>>8&0xFF, id&0xFF)
+	var b strings.Builder
+	for row := 0; row < rows; row++ {
+		b.WriteString(color)
+		b.WriteRune(kittyPlaceholder)
+		b.WriteRune(kittyRowDiacritics[row])
+		b.WriteRune(kittyRowDiacritics[0])
+		b.WriteString(strings.Repeat(string(kittyPlaceholder), cols-1))
+		b.WriteString("\x1b[39m\n")
+	}
+	return b.String()
+}
+
+// inlineImageSentMsg reports the outcome of transmitting an image to the
+// terminal.
+type inlineImageSentMsg struct {
+	id  uint32
+	err error
+}
+
+// transmitInlineImage uploads a kitty image outside the render path. The
+// sequence goes out in a single write, which the os.File write lock
+// serializes against the renderer's frame writes, so it always lands between
+// two frames; it draws nothing by itself.
+func transmitInlineImage(id uint32, seq string) tea.Cmd {
+	return func() tea.Msg {
+		_, err := os.Stdout.WriteString(seq)
+		return inlineImageSentMsg{id: id, err: err}
+	}
+}
@@ -0,0 +1,644 @@
+// Package logformat turns Codex agent transcripts into readable event blocks.
+// It is shared by the formatlogs command and the TUI's live log view.
+package logformat
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Event is one timestamped entry of a transcript together with its body lines.
+type Event struct {
+	Line      int
+	Timestamp string
+	RawHeader string
+	Channel   string
+	Message   string
+	Body      []string
+}
+
+// Attribute is a labelled value of a formatted event; multi-line values are
+// rendered as an indented block.
+type Attribute struct {
+	Label string
+	Value []string
+}
+
+// FormattedEvent is the display form of an Event. Category is a dotted key
+// such as "tool.exec_request" that groups related events.
+type FormattedEvent struct {
+	Title      string
+	Category   string
+	Attributes []Attribute
+}
+
+var headerPattern = regexp.MustCompile(`^\[(\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2})\]\s*(.*)$`)
+
+// ParseFile reads and parses the transcript at path.
+func ParseFile(path string) ([]Event, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return Parse(bufio.NewScanner(file))
+}
+
+// Parse splits a transcript into events. Lines before the first header are
+// kept as a preface event.
+func Parse(scanner *bufio.Scanner) ([]Event, error) {
+	lineNo := 0
+	var preamble []string
+	var events []Event
+	var current *Event
+
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		m := headerPattern.FindStringSubmatch(line)
+		if m != nil {
+			if current != nil {
+				events = append(events, *current)
+			} else if len(preamble) > 0 {
+				events = append(events, Event{
+					Line:      1,
+					Timestamp: "",
+					RawHeader: "preface",
+					Channel:   "",
+					Message:   "",
+					Body:      append([]string{}, preamble...),
+				})
+				preamble = nil
+			}
+			timestamp := strings.TrimSpace(m[1])
+			rest := strings.TrimSpace(m[2])
+			channel, message := splitChannel(rest)
+			current = &Event{
+				Line:      lineNo,
+				Timestamp: timestamp,
+				RawHeader: rest,
+				Channel:   channel,
+				Message:   message,
+			}
+			continue
+		}
+
+		if current == nil {
+			preamble = append(preamble, line)
+			continue
+		}
+		current.Body = append(current.Body, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if current != nil {
+		events = append(events, *current)
+	}
+
+	return events, nil
+}
+
+func splitChannel(rest string) (string, string) {
+	if rest == "" {
+		return "", ""
+	}
+	parts := strings.Fields(rest)
+	if len(parts) == 0 {
+		return "", rest
+	}
+	first := parts[0]
+	if isChannelToken(first) {
+		msg := strings.TrimSpace(rest[len(first):])
+		return first, msg
+	}
+	return "", rest
+}
+
+func isChannelToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r >= 'A' && r <= 'Z' {
+			return false
+		}
+		if !(r == '-' || r == '_' || (r >= 'a' && r <= 'z')) {
+			return false
+		}
+	}
+	return true
+}
+
+// Render formats events as text blocks. Large attributes are written to store
+// when it is non-nil and replaced by a reference.
+func Render(events []Event, sourcePath string, store *ArtifactStore) (string, error) {
+	return RenderWith(events, sourcePath, store, RenderOptions{})
+}
+
+// RenderOptions customizes RenderWith. The zero value renders like Render.
+type RenderOptions struct {
+	// Redactor masks secrets in each event before anything is externalized.
+	Redactor *Redactor
+	// Decorate, when set, may rewrite each event's rendered block.
+	Decorate func(evt FormattedEvent, lines []string) []string
+}
+
+// RenderWith is Render with redaction and per-block decoration.
+func RenderWith(events []Event, sourcePath string, store *ArtifactStore, opts RenderOptions) (string, error) {
+	var out []string
+	for _, evt := range events {
+		formatted := opts.Redactor.Event(Format(evt))
+		lines, err := RenderEvent(formatted, sourcePath, evt.Line, store)
+		if err != nil {
+			return "", err
+		}
+		if opts.Decorate != nil {
+			lines = opts.Decorate(formatted, lines)
+		}
+		out = append(out, lines...)
+		out = append(out, "")
+	}
+	if len(out) > 0 {
+		out = out[:len(out)-1]
+	}
+	return strings.Join(out, "\n"), nil
+}
+
+// Format classifies evt and extracts its attributes.
+func Format(evt Event) FormattedEvent {
+	switch {
+	case evt.Timestamp == "" && len(evt.Body) > 0:
+		return FormattedEvent{
+			Title:    "Preface",
+			Category: "context.metadata",
+			Attributes: []Attribute{
+				{Label: "lines", Value: trimEmpty(evt.Body)},
+			},
+		}
+	case strings.Contains(evt.RawHeader, "OpenAI Codex"):
+		return formatContextInit(evt)
+	case strings.HasSuffix(evt.RawHeader, "User instructions:"):
+		return formatUserInstructions(evt)
+	case strings.Contains(strings.ToLower(evt.RawHeader), "shared context"):
+		return formatContextManifest(evt)
+	case evt.Channel == "thinking":
+		return formatThinking(evt)
+	case evt.Channel == "codex":
+		return formatCodexStage(evt)
+	case evt.Channel == "exec":
+		return formatExec(evt)
+	case evt.Channel == "bash":
+		return formatBash(evt)
+	case evt.Channel == "tokens":
+		return formatTokens(evt)
+	case strings.HasPrefix(evt.Channel, "apply_patch"):
+		return formatApplyPatch(evt)
+	case evt.Channel == "turn" && strings.HasPrefix(strings.TrimSpace(evt.Message), "diff"):
+		return formatDiff(evt)
+	default:
+		return formatDefault(evt)
+	}
+}
+
+func formatContextInit(evt Event) FormattedEvent {
+	attrs := []Attribute{
+		{Label: "timestamp", Value: []string{evt.Timestamp}},
+		{Label: "agent_version", Value: []string{evt.RawHeader}},
+	}
+	for _, line := range evt.Body {
+		line = strings.TrimSpace(line)
+		if line == "" || line == "--------" {
+			continue
+		}
+		if kv := strings.SplitN(line, ":", 2); len(kv) == 2 {
+			key := strings.TrimSpace(strings.ReplaceAll(kv[0], " ", "_"))
+			value := strings.TrimSpace(kv[1])
+			attrs = append(attrs, Attribute{Label: key, Value: []string{value}})
+		}
+	}
+	return FormattedEvent{
+		Title:      "Run Context",
+		Category:   "context.init",
+		Attributes: attrs,
+	}
+}
+
+func formatUserInstructions(evt Event) FormattedEvent {
+	body := trimEmpty(evt.Body)
+	return FormattedEvent{
+		Title:    "User Brief",
+		Category: "context.instructions",
+		Attributes: []Attribute{
+			{Label: "timestamp", Value: []string{evt.Timestamp}},
+			{Label: "instructions", Value: body},
+		},
+	}
+}
+
+func formatContextManifest(evt Event) FormattedEvent {
+	var artifacts []string
+	var notes []string
+	for _, line := range evt.Body {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "### ") {
+			artifacts = append(artifacts, line[4:])
+			continue
+		}
+		if strings.Contains(line, ":") {
+			notes = append(notes, line)
+			continue
+		}
+		notes = append(notes, line)
+	}
+	attrs := []Attribute{
+		{Label: "timestamp", Value: []string{evt.Timestamp}},
+	}
+	if len(artifacts) > 0 {
+		attrs = append(attrs, Attribute{Label: "artifacts", Value: artifacts})
+	}
+	if len(notes) > 0 {
+		attrs = append(attrs, Attribute{Label: "notes", Value: notes})
+	}
+	return FormattedEvent{
+		Title:      "Shared Context",
+		Category:   "context.manifest",
+		Attributes: attrs,
+	}
+}
+
+func formatThinking(evt Event) FormattedEvent {
+	heading := ""
+	var narrative []string
+	for _, line := range evt.Body {
+		trim := strings.TrimSpace(line)
+		if trim == "" {
+			continue
+		}
+		if strings.HasPrefix(trim, "**") && strings.HasSuffix(trim, "**") && len(trim) > 4 {
+			heading = strings.Trim(trim, "*")
+			continue
+		}
+		narrative = append(narrative, trim)
+	}
+	if heading == "" {
+		heading = "Agent Thinking"
+	}
+	return FormattedEvent{
+		Title:    heading,
+		Category: "cognition.start",
+		Attributes: []Attribute{
+			{Label: "timestamp", Value: []string{evt.Timestamp}},
+			{Label: "notes", Value: narrative},
+		},
+	}
+}
+
+func formatCodexStage(evt Event) FormattedEvent {
+	body := trimEmpty(evt.Body)
+	return FormattedEvent{
+		Title:    "Execution Stage",
+		Category: "cognition.stage",
+		Attributes: []Attribute{
+			{Label: "timestamp", Value: []string{evt.Timestamp}},
+			{Label: "detail", Value: body},
+		},
+	}
+}
+
+func formatExec(evt Event) FormattedEvent {
+	command := strings.TrimSpace(evt.Message)
+	cwd := ""
+	if idx := strings.LastIndex(command, " in "); idx != -1 {
+		cwd = strings.TrimSpace(command[idx+4:])
+		command = strings.TrimSpace(command[:idx])
+	}
+	return FormattedEvent{
+		Title:    "Shell Invocation",
+		Category: "tool.exec_request",
+		Attributes: []Attribute{
+			{Label: "timestamp", Value: []string{evt.Timestamp}},
+			{Label: "command", Value: []string{command}},
+			{Label: "cwd", Value: []string{cwd}},
+		},
+	}
+}
+
+func formatBash(evt Event) FormattedEvent {
+	status := "unknown"
+	duration := ""
+	message := strings.TrimSpace(evt.Message)
+	if strings.Contains(message, " succeeded") {
+		status = "success"
+	} else if strings.Contains(message, " failed") {
+		status = "failed"
+	}
+	if idx := strings.LastIndex(message, "in "); idx != -1 {
+		duration = strings.Trim(strings.TrimSuffix(message[idx+3:], ":"), " ")
+		message = strings.TrimSpace(message[:idx])
+	}
+	if strings.HasSuffix(message, " succeeded") {
+		message = strings.TrimSpace(strings.TrimSuffix(message, " succeeded"))
+	} else if strings.HasSuffix(message, " failed") {
+		message = strings.TrimSpace(strings.TrimSuffix(message, " failed"))
+	}
+	attrs := []Attribute{
+		{Label: "timestamp", Value: []string{evt.Timestamp}},
+		{Label: "status", Value: []string{status}},
+	}
+	if duration != "" {
+		attrs = append(attrs, Attribute{Label: "duration", Value: []string{duration}})
+	}
+	if message != "" {
+		attrs = append(attrs, Attribute{Label: "command", Value: []string{message}})
+	}
+	stdout := trimTrailingEmpty(evt.Body)
+	if len(stdout) > 0 {
+		attrs = append(attrs, Attribute{Label: "output", Value: stdout})
+	}
+	return FormattedEvent{
+		Title:      "Command Result",
+		Category:   "tool.exec_result",
+		Attributes: attrs,
+	}
+}
+
+func formatTokens(evt Event) FormattedEvent {
+	value := strings.TrimSpace(evt.Message)
+	if strings.HasPrefix(value, "used:") {
+		value = strings.TrimSpace(strings.TrimPrefix(value, "used:"))
+	}
+	return FormattedEvent{
+		Title:    "Token Snapshot",
+		Category: "telemetry.tokens",
+		Attributes: []Attribute{
+			{Label: "timestamp", Value: []string{evt.Timestamp}},
+			{Label: "tokens_used", Value: []string{value}},
+		},
+	}
+}
+
+func formatApplyPatch(evt Event) FormattedEvent {
+	message := strings.TrimSpace(evt.RawHeader)
+	details := trimEmpty(evt.Body)
+	return FormattedEvent{
+		Title:    "Patch Application",
+		Category: "tool.patch_result",
+		Attributes: []Attribute{
+			{Label: "timestamp", Value: []string{evt.Timestamp}},
+			{Label: "summary", Value: []string{message}},
+			{Label: "details", Value: details},
+		},
+	}
+}
+
+func formatDiff(evt Event) FormattedEvent {
+	diffLines := trimTrailingEmpty(evt.Body)
+	return FormattedEvent{
+		Title:    "Diff Artifact",
+		Category: "output.diff_body",
+		Attributes: []Attribute{
+			{Label: "timestamp", Value: []string{evt.Timestamp}},
+			{Label: "diff", Value: diffLines},
+		},
+	}
+}
+
+func formatDefault(evt Event) FormattedEvent {
+	body := trimEmpty(evt.Body)
+	label := "message"
+	if evt.Channel != "" {
+		label = evt.Channel
+	}
+	attrs := []Attribute{
+		{Label: "timestamp", Value: []string{evt.Timestamp}},
+	}
+	if evt.Message != "" {
+		attrs = append(attrs, Attribute{Label: "summary", Value: []string{evt.Message}})
+	}
+	if len(body) > 0 {
+		attrs = append(attrs, Attribute{Label: label, Value: body})
+	}
+	return FormattedEvent{
+		Title:      "Log Entry",
+		Category:   "log.raw",
+		Attributes: attrs,
+	}
+}
+
+// RenderEvent renders a single formatted event as a delimited text block.
+// line is the event's line in sourcePath; store may be nil.
+func RenderEvent(evt FormattedEvent, sourcePath string, line int, store *ArtifactStore) ([]string, error) {
+	var out []string
+	out = append(out, "------------------")
+
+	location := sourcePath
+	if rel, err := filepath.Rel(".", sourcePath); err == nil {
+		location = rel
+	}
+	title := evt.Title
+	if title == "" {
+		title = "Log Entry"
+	}
+	category := evt.Category
+	if category == "" {
+		category = "log.raw"
+	}
+	out = append(out, fmt.Sprintf("%s · %s (%s:%d)", title, category, location, line))
+	out = append(out, "------------------")
+	for _, attr := range evt.Attributes {
+		if len(attr.Value) == 0 {
+			continue
+		}
+		if store != nil {
+			var err error
+			attr, err = store.maybeExternalize(evt, line, attr)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(attr.Value) == 1 && attr.Value[0] != "" && !strings.Contains(attr.Value[0], "\n") {
+			out = append(out, fmt.Sprintf("%s: %s", attr.Label, attr.Value[0]))
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s:", attr.Label))
+		for _, v := range attr.Value {
+			if v == "" {
+				out = append(out, "  ")
+			} else {
+				out = append(out, "  "+v)
+			}
+		}
+	}
+	out = append(out, "------------------")
+	return out, nil
+}
+
+func trimEmpty(lines []string) []string {
+	var out []string
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		out = append(out, strings.TrimRightFunc(line, func(r rune) bool {
+			return r == ' ' || r == '\t'
+		}))
+	}
+	return out
+}
+
+func trimTrailingEmpty(lines []string) []string {
+	end := len(lines)
+	for end > 0 {
+		if strings.TrimSpace(lines[end-1]) != "" {
+			break
+		}
+		end--
+	}
+	lines = lines[:end]
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " \t")
+	}
+	return lines
+}
+
+// ArtifactStore writes oversized attributes to numbered files in dir.
+type ArtifactStore struct {
+	dir     string
+	counter int
+}
+
+const (
+	maxInlineLines = 40
+	maxInlineChars = 4000
+)
+
+// ResolveArtifactDir picks the artifact directory: the flag value when set,
+// otherwise "<name>.artifacts" beside the output (or input) file.
+func ResolveArtifactDir(inputPath, outputPath, flagValue string) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+	baseDir := filepath.Dir(inputPath)
+	baseName := strings.TrimSuffix(filepath.Base(inputPath), filepath.Ext(inputPath))
+	if outputPath != "" {
+		baseDir = filepath.Dir(outputPath)
+		baseName = strings.TrimSuffix(filepath.Base(outputPath), filepath.Ext(outputPath))
+	}
+	return filepath.Join(baseDir, baseName+".artifacts"), nil
+}
+
+// NewArtifactStore creates dir and returns a store for it; an empty dir
+// disables externalizing.
+func NewArtifactStore(dir string) (*ArtifactStore, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &ArtifactStore{dir: dir}, nil
+}
+
+func (s *ArtifactStore) maybeExternalize(evt FormattedEvent, line int, attr Attribute) (Attribute, error) {
+	if s == nil || len(attr.Value) == 0 {
+		return attr, nil
+	}
+	if !shouldExternalize(evt, attr) {
+		return attr, nil
+	}
+	path, checksum, err := s.saveArtifact(evt, line, attr)
+	if err != nil {
+		return attr, err
+	}
+	lines := len(attr.Value)
+	attr.Value = []string{fmt.Sprintf("[artifact] %s (lines:%d, sha256:%s)", path, lines, checksum)}
+	return attr, nil
+}
+
+func shouldExternalize(evt FormattedEvent, attr Attribute) bool {
+	label := strings.ToLower(attr.Label)
+	if label == "instructions" {
+		return false
+	}
+	if evt.Category == "output.diff_body" {
+		if strings.Contains(label, "diff") {
+			return true
+		}
+		return false
+	}
+	if strings.Contains(label, "diff") {
+		return true
+	}
+	if label == "output" || label == "stdout" || label == "stderr" {
+		return exceedsThreshold(attr.Value)
+	}
+	return exceedsThreshold(attr.Value)
+}
+
+func exceedsThreshold(values []string) bool {
+	lineCount := 0
+	charCount := 0
+	for _, v := range values {
+		lineCount++
+		charCount += len(v)
+	}
+	return lineCount > maxInlineLines || charCount > maxInlineChars
+}
+
+func (s *ArtifactStore) saveArtifact(evt FormattedEvent, line int, attr Attribute) (string, string, error) {
+	s.counter++
+	content := strings.Join(attr.Value, "\n")
+	if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	baseName := fmt.Sprintf("%04d_%s_%s_%d.txt", s.counter, sanitizeForName(evt.Category), sanitizeForName(attr.Label), line)
+	fullPath := filepath.Join(s.dir, baseName)
+	if err := os.WriteFile(fullPath, []byte(content), 0o644); err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256([]byte(content))
+	checksum := hex.EncodeToString(sum[:])
+	relPath, err := filepath.Rel(".", fullPath)
+	if err != nil {
+		relPath = fullPath
+	}
+	return filepath.ToSlash(relPath), checksum, nil
+}
+
+func sanitizeForName(input string) string {
+	if input == "" {
+		return "artifact"
+	}
+	var b strings.Builder
+	for _, r := range input {
+		switch {
+		case r >= 'a' && r <= 'z':
+			b.WriteRune(r)
+		case r >= 'A' && r <= 'Z':
+			b.WriteRune(r)
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '-' || r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('-')
+		}
+	}
+	result := strings.Trim(b.String(), "-_")
+	if result == "" {
+		return "artifact"
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package logformat
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RedactedMask replaces secret values in redacted output.
+const RedactedMask = "[REDACTED]"
+
+var defaultSecretPatterns = []string{
+	`\bsk-[A-Za-z0-9_-]{16,}`,
+	`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`,
+	`\bgh[pousr]_[A-Za-z0-9]{30,}`,
+	`\bxox[abprs]-[A-Za-z0-9-]{10,}`,
+	`(?i)\bbearer\s+([A-Za-z0-9._~+/-]+=*)`,
+	`(?i)\b(?:api[_-]?key|secret|token|password)\s*[=:]\s*["']?([^\s"']{8,})`,
+}
+
+// Redactor masks secrets in formatted events. Patterns with a capture group
+// mask only the first group, so "Bearer <token>" keeps its scheme. A nil
+// Redactor leaves events untouched.
+type Redactor struct {
+	patterns []*regexp.Regexp
+}
+
+// NewRedactor compiles the built-in secret patterns plus extra.
+func NewRedactor(extra []string) (*Redactor, error) {
+	r := &Redactor{}
+	for _, expr := range append(append([]string{}, defaultSecretPatterns...), extra...) {
+		if strings.TrimSpace(expr) == "" {
+			continue
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("redact pattern %q: %w", expr, err)
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	return r, nil
+}
+
+// Event returns a copy of evt with secrets masked in its title and attribute
+// values. Apply it before RenderEvent so externalized artifacts are masked too.
+func (r *Redactor) Event(evt FormattedEvent) FormattedEvent {
+	if r == nil {
+		return evt
+	}
+	evt.Title = r.String(evt.Title)
+	attrs := make([]Attribute, len(evt.Attributes))
+	for i, attr := range evt.Attributes {
+		values := make([]string, len(attr.Value))
+		for j, v := range attr.Value {
+			values[j] = r.String(v)
+		}
+		attrs[i] = Attribute{Label: attr.Label, Value: values}
+	}
+	evt.Attributes = attrs
+	return evt
+}
+
+// String masks every secret in s.
+func (r *Redactor) String(s string) string {
+	if r == nil || s == "" {
+		return s
+	}
+	for _, re := range r.patterns {
+		if re.NumSubexp() == 0 {
+			s = re.ReplaceAllLiteralString(s, RedactedMask)
+			continue
+		}
+		s = re.ReplaceAllStringFunc(s, func(match string) string {
+			loc := re.FindStringSubmatchIndex(match)
+			if len(loc) < 4 || loc[2] < 0 {
+				return RedactedMask
+			}
+			return match[:loc[2]] + RedactedMask + match[loc[3]:]
+		})
+	}
+	return s
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// jobHistorySamples caps the successful run durations kept per job title.
+	jobHistorySamples = 10
+	// minJobETASamples is how many past runs a title needs before an ETA is
+	// shown.
+	minJobETASamples = 2
+)
+
+// jobDurationHistory maps a job title to the durations of its most recent
+// successful runs, in milliseconds, oldest first.
+type jobDurationHistory map[string][]int64
+
+func jobHistoryPath() string {
+	return filepath.Join(resolveStateDir(), "job-durations.json")
+}
+
+func loadJobDurationHistory() jobDurationHistory {
+	data, err := os.ReadFile(jobHistoryPath())
+	if err != nil {
+		return jobDurationHistory{}
+	}
+	var history jobDurationHistory
+	if json.Unmarshal(data, &history) != nil || history == nil {
+		return jobDurationHistory{}
+	}
+	return history
+}
+
+func saveJobDurationHistory(history jobDurationHistory) error {
+	data, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := jobHistoryPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// record appends a successful run of title, dropping the oldest samples.
+func (h jobDurationHistory) record(title string, d time.Duration) {
+	if title == "" || d <= 0 {
+		return
+	}
+	samples := append(h[title], d.Milliseconds())
+	if len(samples) > jobHistorySamples {
+		samples = samples[len(samples)-jobHistorySamples:]
+	}
+	h[title] = samples
+}
+
+// estimate averages the recorded runs of title. It reports false until
+// minJobETASamples runs exist.
+func (h jobDurationHistory) estimate(title string) (time.Duration, bool) {
+	samples := h[title]
+	if len(samples) < minJobETASamples {
+		return 0, false
+	}
+	var total int64
+	for _, ms := range samples {
+		total += ms
+	}
+	return time.Duration(total/int64(len(samples))) * time.Millisecond, true
+}
@@ -103,6 +103,14 @@ func (jm *jobManager) SetMaxParallel(n int) tea.Cmd {
 	return jm.startJobs()
 }
 
+// ActiveCount returns how many jobs are running or still queued.
+func (jm *jobManager) ActiveCount() int {
+	if jm == nil {
+		return 0
+	}
+	return len(jm.running) + len(jm.queue)
+}
+
 func (jm *jobManager) Cancel(id int) (bool, tea.Cmd) {
 	if state, ok := jm.running[id]; ok {
 		state.cancelOnce.Do(func() {
@@ -10,8 +10,10 @@ import (
 )
 
 const (
-	logsColumnWidth  = 88
-	logsColumnHeight = 14
+	logsColumnWidth     = 88
+	logsColumnHeight    = 14
+	minLogsColumnHeight = 6
+	maxLogsColumnHeight = 40
 )
 
 type logsColumn struct {
@@ -263,6 +265,12 @@ func (c *logsColumn) FocusValue() string {
 	if c.model.logsSelectionActive && c.model.logsSelectionCursor >= 0 && c.model.logsSelectionCursor < total {
 		return fmt.Sprintf("Line %d/%d", c.model.logsSelectionCursor+1, total)
 	}
+	if query := c.model.logsFindQuery; query != "" {
+		if matches := len(c.model.logsFindMatches); matches > 0 && c.model.logsFindCurrent >= 0 {
+			return fmt.Sprintf("/%s %d/%d", query, c.model.logsFindCurrent+1, matches)
+		}
+		return fmt.Sprintf("/%s no matches", query)
+	}
 	start := c.model.logs.YOffset + 1
 	end := start + c.model.logs.Height - 1
 	if end > total {
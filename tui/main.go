@@ -9,12 +9,26 @@ import (
 )
 
 func main() {
-	theme := flag.String("theme", "auto", "Markdown rendering theme: auto, light, or dark")
+	theme := flag.String("theme", "auto", "Markdown rendering theme: auto, light, dark, or high-contrast; saved for later launches")
+	themeEphemeral := flag.String("theme-ephemeral", "", "Markdown rendering theme for this session only")
+	readOnly := flag.Bool("read-only", false, "Browse without running commands or writing project files (for demos)")
 	flag.Parse()
-	setMarkdownTheme(markdownThemeFromString(*theme))
 
+	var flagTheme markdownTheme
+	flagThemeSet, persistTheme := false, false
+	if *themeEphemeral != "" {
+		flagTheme, flagThemeSet = themeFlagValue("theme-ephemeral", *themeEphemeral), true
+	} else if flagPassed("theme") {
+		flagTheme, flagThemeSet, persistTheme = themeFlagValue("theme", *theme), true, true
+	}
+
+	m := initialModel()
+	m.readOnly = *readOnly
+	if flagThemeSet {
+		m.applyThemeFlag(flagTheme, persistTheme)
+	}
 	if _, err := tea.NewProgram(
-		initialModel(),
+		m,
 		tea.WithAltScreen(),
 		tea.WithMouseAllMotion(),
 	).Run(); err != nil {
@@ -22,3 +36,25 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// themeFlagValue parses a theme flag, exiting with a usage error for values
+// that name no theme rather than silently falling back to auto.
+func themeFlagValue(name, value string) markdownTheme {
+	theme, ok := parseMarkdownTheme(value)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "invalid value %q for -%s: want auto, light, dark, or high-contrast\n", value, name)
+		flag.Usage()
+		os.Exit(2)
+	}
+	return theme
+}
+
+func flagPassed(name string) bool {
+	passed := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			passed = true
+		}
+	})
+	return passed
+}
@@ -1,10 +1,12 @@
 package main
 
 import (
+	"regexp"
 	"strings"
 	"sync"
 
 	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/glamour/ansi"
 )
 
 type markdownTheme string
@@ -13,6 +15,9 @@ const (
 	markdownThemeAuto  markdownTheme = "auto"
 	markdownThemeDark  markdownTheme = "dark"
 	markdownThemeLight markdownTheme = "light"
+	// markdownThemeHighContrast also swaps the lipgloss styles, see
+	// highContrastStyles.
+	markdownThemeHighContrast markdownTheme = "high-contrast"
 )
 
 var (
@@ -55,6 +60,8 @@ func ensureMarkdownRenderer() *glamour.TermRenderer {
 		options = append(options, glamour.WithStandardStyle("light"))
 	case markdownThemeDark:
 		options = append(options, glamour.WithStandardStyle("dark"))
+	case markdownThemeHighContrast:
+		options = append(options, glamour.WithStyles(highContrastMarkdownStyle()))
 	}
 	markdownRenderer, markdownErr = glamour.NewTermRenderer(options...)
 	if markdownErr != nil {
@@ -96,15 +103,24 @@ func currentMarkdownTheme() markdownTheme {
 }
 
 func markdownThemeFromString(value string) markdownTheme {
+	theme, _ := parseMarkdownTheme(value)
+	return theme
+}
+
+// parseMarkdownTheme is markdownThemeFromString that also reports whether
+// value named a theme, for validating user input.
+func parseMarkdownTheme(value string) (markdownTheme, bool) {
 	switch strings.ToLower(strings.TrimSpace(value)) {
 	case "dark":
-		return markdownThemeDark
+		return markdownThemeDark, true
 	case "light":
-		return markdownThemeLight
+		return markdownThemeLight, true
 	case "auto":
-		return markdownThemeAuto
+		return markdownThemeAuto, true
+	case "high-contrast", "highcontrast", "contrast", "hc":
+		return markdownThemeHighContrast, true
 	default:
-		return markdownThemeAuto
+		return markdownThemeAuto, false
 	}
 }
 
@@ -114,6 +130,8 @@ func (t markdownTheme) String() string {
 		return "dark"
 	case markdownThemeLight:
 		return "light"
+	case markdownThemeHighContrast:
+		return "high-contrast"
 	default:
 		return "auto"
 	}
@@ -125,6 +143,8 @@ func markdownThemeLabel(theme markdownTheme) string {
 		return "Dark"
 	case markdownThemeLight:
 		return "Light"
+	case markdownThemeHighContrast:
+		return "High contrast"
 	default:
 		return "Auto"
 	}
@@ -136,7 +156,71 @@ func nextMarkdownTheme(theme markdownTheme) markdownTheme {
 		return markdownThemeDark
 	case markdownThemeDark:
 		return markdownThemeLight
+	case markdownThemeLight:
+		return markdownThemeHighContrast
 	default:
 		return markdownThemeAuto
 	}
 }
+
+// highContrastMarkdownStyle is glamour's dark style with plain white text,
+// inverted headings and bright links so nothing relies on subtle shades.
+func highContrastMarkdownStyle() ansi.StyleConfig {
+	white, black, yellow, cyan := "15", "0", "11", "14"
+	bold := true
+	style := glamour.DarkStyleConfig
+	style.Document.Color = &white
+	style.Paragraph.Color = &white
+	style.Heading.Color = &yellow
+	style.H1.Color = &black
+	style.H1.BackgroundColor = &yellow
+	style.H6.Color = &yellow
+	style.HorizontalRule.Color = &white
+	style.Link.Color = &cyan
+	style.Link.Underline = &bold
+	style.LinkText.Color = &cyan
+	style.LinkText.Bold = &bold
+	style.Code.Color = &yellow
+	style.Code.BackgroundColor = &black
+	style.CodeBlock.Color = &white
+	style.CodeBlock.Chroma = nil
+	return style
+}
+
+// markdownHeading is an ATX heading found in a markdown document.
+type markdownHeading struct {
+	Level int
+	Text  string
+}
+
+var markdownLinkPattern = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+
+// parseMarkdownHeadings lists "#" headings in document order, skipping fenced
+// code blocks. Inline links and emphasis markers are dropped from the text so
+// it matches what glamour renders.
+func parseMarkdownHeadings(content string) []markdownHeading {
+	var headings []markdownHeading
+	inFence := false
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence || !strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+		rest := trimmed[level:]
+		if level > 6 || (rest != "" && rest[0] != ' ' && rest[0] != '\t') {
+			continue
+		}
+		text := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(rest), "#"))
+		text = markdownLinkPattern.ReplaceAllString(text, "$1")
+		text = strings.NewReplacer("**", "", "__", "", "`", "", "*", "").Replace(text)
+		if text = strings.TrimSpace(text); text != "" {
+			headings = append(headings, markdownHeading{Level: level, Text: text})
+		}
+	}
+	return headings
+}
@@ -44,6 +44,13 @@ const (
 
 const horizontalScrollStep = 4
 
+// textareaCharLimit and textareaMaxHeight bound the multi-line input overlay;
+// the notes editor lifts both while it is open.
+const (
+	textareaCharLimit = 4096
+	textareaMaxHeight = 99
+)
+
 type workspaceItemKind int
 
 const (
@@ -62,6 +69,7 @@ const (
 	inputNewProjectConfirm
 	inputAttachRFP
 	inputCommandPalette
+	inputProjectSearch
 	inputEnvEditValue
 	inputEnvNewKey
 	inputEnvNewValue
@@ -69,6 +77,21 @@ const (
 	inputSettingsWorkspaceRemove
 	inputSettingsDockerPath
 	inputSettingsConcurrency
+	inputSettingsServicesPoll
+	inputSettingsDashboardRefresh
+	inputSettingsLogLines
+	inputSettingsExport
+	inputSettingsImport
+	inputTelemetryFilter
+	inputCommandConfirm
+	inputSettingsConfirmCommands
+	inputDocDiffBase
+	inputPreviewFind
+	inputLogsFind
+	inputJobEnv
+	inputRecentFiles
+	inputSettingsFeatures
+	inputNotes
 )
 
 type workspaceRoot struct {
@@ -104,6 +127,12 @@ type focusAreaMsg struct {
 	area focusArea
 }
 
+// sessionCommand is a gpt-creator invocation queued during this session.
+type sessionCommand struct {
+	dir  string
+	args []string
+}
+
 type paletteEntry struct {
 	label           string
 	command         []string
@@ -112,6 +141,11 @@ type paletteEntry struct {
 	meta            map[string]string
 }
 
+type projectFeatureState struct {
+	feature string
+	itemKey string
+}
+
 type envFilesLoadedMsg struct {
 	states []*envFileState
 	err    error
@@ -176,8 +210,14 @@ type jobStatus struct {
 	Ended           time.Time
 	Err             string
 	CancelRequested bool
+	Dir             string
+	// Output keeps the job's own log lines, up to maxJobOutputLines, so they
+	// can be saved as a report after the combined log has moved on.
+	Output []string
 }
 
+const maxJobOutputLines = 5000
+
 type workspaceSelectedMsg struct {
 	item workspaceItem
 }
@@ -248,6 +288,8 @@ type backlogStatusUpdatedMsg struct {
 type tokensLoadedMsg struct {
 	usage *tokensUsage
 	err   error
+	// refresh marks a periodic reload that keeps the selected row.
+	refresh bool
 }
 
 type tokensRowSelectedMsg struct {
@@ -266,6 +308,13 @@ type tokensExportedMsg struct {
 type reportsLoadedMsg struct {
 	entries []reportEntry
 	err     error
+	// refresh marks a periodic reload that keeps the selected row.
+	refresh bool
+}
+
+type projectSearchLoadedMsg struct {
+	entries []paletteEntry
+	errs    []string
 }
 
 type reportsRowSelectedMsg struct {
@@ -273,8 +322,23 @@ type reportsRowSelectedMsg struct {
 	activate bool
 }
 
+type editorExitedMsg struct {
+	command string
+	err     error
+}
+
+type telemetryLoadedMsg struct {
+	events []telemetryEvent
+	err    error
+}
+
+type telemetryRowSelectedMsg struct {
+	event telemetryEvent
+}
+
 type servicesLoadedMsg struct {
-	items []featureItemDefinition
+	items       []featureItemDefinition
+	composePath string
 }
 
 type chatRole string
@@ -300,7 +364,16 @@ const (
 	maxChatPromptMessages    = 20
 )
 
-const servicesPollInterval = 2 * time.Second
+const (
+	defaultServicesPollSeconds = 2
+	maxServicesPollSeconds     = 300
+	maxDashboardRefreshSeconds = 600
+	dashboardRefreshStep       = 15
+	defaultLogLines            = 400
+	minLogLines                = 100
+	maxLogLines                = 5000
+	logLinesStep               = 100
+)
 
 type keyMap struct {
 	quit         key.Binding
@@ -317,17 +390,36 @@ type keyMap struct {
 	logsBottom   key.Binding
 	logsSelect   key.Binding
 	logsCopy     key.Binding
+	logsFailure  key.Binding
+	logsClear    key.Binding
+	logsGrow     key.Binding
+	logsShrink   key.Binding
+	colWiden     key.Binding
+	colNarrow    key.Binding
 	openPalette  key.Binding
+	jumpProject  key.Binding
 	closePal     key.Binding
 	runPal       key.Binding
 	openEditor   key.Binding
 	togglePin    key.Binding
 	copyPath     key.Binding
 	copySnippet  key.Binding
+	copyPreview  key.Binding
+	findPreview  key.Binding
+	reloadProj   key.Binding
 	toggleSplit  key.Binding
+	toggleWrap   key.Binding
+	lineNumbers  key.Binding
+	cycleTheme   key.Binding
 	cancelJob    key.Binding
 	toggleHelp   key.Binding
 	focusChat    key.Binding
+	focusLogs    key.Binding
+	focusMode    key.Binding
+	credentials  key.Binding
+	rerunLast    key.Binding
+	navBack      key.Binding
+	navForward   key.Binding
 }
 
 func newKeyMap() keyMap {
@@ -388,14 +480,66 @@ func newKeyMap() keyMap {
 			key.WithKeys("ctrl+c", "cmd+c", "ctrl+shift+c"),
 			key.WithHelp("ctrl/cmd+c", "copy log selection"),
 		),
+		logsFailure: key.NewBinding(
+			key.WithKeys("ctrl+l"),
+			key.WithHelp("ctrl+l", "reveal last failure in logs"),
+		),
+		logsClear: key.NewBinding(
+			key.WithKeys("ctrl+x"),
+			key.WithHelp("ctrl+x", "clear logs"),
+		),
+		logsGrow: key.NewBinding(
+			key.WithKeys("alt+up", "ctrl+shift+up"),
+			key.WithHelp("alt+↑", "grow log panel"),
+		),
+		logsShrink: key.NewBinding(
+			key.WithKeys("alt+down", "ctrl+shift+down"),
+			key.WithHelp("alt+↓", "shrink log panel"),
+		),
+		colWiden: key.NewBinding(
+			key.WithKeys("alt+right", "ctrl+shift+right"),
+			key.WithHelp("alt+→", "widen column"),
+		),
+		colNarrow: key.NewBinding(
+			key.WithKeys("alt+left", "ctrl+shift+left"),
+			key.WithHelp("alt+←", "narrow column"),
+		),
 		focusChat: key.NewBinding(
 			key.WithKeys("f7"),
 			key.WithHelp("F7", "focus chat"),
 		),
+		focusLogs: key.NewBinding(
+			key.WithKeys("f8"),
+			key.WithHelp("F8", "focus logs"),
+		),
+		focusMode: key.NewBinding(
+			key.WithKeys("z"),
+			key.WithHelp("z", "focus mode"),
+		),
+		credentials: key.NewBinding(
+			key.WithKeys("ctrl+g"),
+			key.WithHelp("ctrl+g", "credentials (env editor)"),
+		),
+		rerunLast: key.NewBinding(
+			key.WithKeys("."),
+			key.WithHelp(".", "re-run last command"),
+		),
+		navBack: key.NewBinding(
+			key.WithKeys("ctrl+o"),
+			key.WithHelp("ctrl+o", "jump back"),
+		),
+		navForward: key.NewBinding(
+			key.WithKeys("ctrl+n"),
+			key.WithHelp("ctrl+n", "jump forward"),
+		),
 		openPalette: key.NewBinding(
 			key.WithKeys(":"),
 			key.WithHelp(":", "command palette"),
 		),
+		jumpProject: key.NewBinding(
+			key.WithKeys("ctrl+f"),
+			key.WithHelp("ctrl+f", "jump to project"),
+		),
 		closePal: key.NewBinding(
 			key.WithKeys("esc"),
 			key.WithHelp("esc", "close palette"),
@@ -420,10 +564,34 @@ func newKeyMap() keyMap {
 			key.WithKeys("Y"),
 			key.WithHelp("Y", "copy snippet"),
 		),
+		copyPreview: key.NewBinding(
+			key.WithKeys("ctrl+y"),
+			key.WithHelp("ctrl+y", "copy preview"),
+		),
+		findPreview: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/ n N", "find in preview or logs"),
+		),
+		reloadProj: key.NewBinding(
+			key.WithKeys("ctrl+r", "f5"),
+			key.WithHelp("ctrl+r", "reload project"),
+		),
 		toggleSplit: key.NewBinding(
 			key.WithKeys("s"),
 			key.WithHelp("s", "toggle split"),
 		),
+		toggleWrap: key.NewBinding(
+			key.WithKeys("W"),
+			key.WithHelp("W", "toggle preview wrap"),
+		),
+		lineNumbers: key.NewBinding(
+			key.WithKeys("#"),
+			key.WithHelp("#", "toggle line numbers"),
+		),
+		cycleTheme: key.NewBinding(
+			key.WithKeys("ctrl+t"),
+			key.WithHelp("ctrl+t", "cycle theme"),
+		),
 		cancelJob: key.NewBinding(
 			key.WithKeys("ctrl+k"),
 			key.WithHelp("ctrl+k", "cancel job"),
@@ -443,6 +611,7 @@ func (k keyMap) ShortHelp() []key.Binding {
 		k.prevFeature,
 		k.openPalette,
 		k.focusChat,
+		k.focusLogs,
 		k.toggleLogs,
 		k.toggleHelp,
 		k.quit,
@@ -452,13 +621,105 @@ func (k keyMap) ShortHelp() []key.Binding {
 func (k keyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.nextFocus, k.prevFocus, k.nextFeature, k.prevFeature},
-		{k.openPalette, k.runPal, k.closePal},
+		{k.openPalette, k.jumpProject, k.runPal, k.closePal},
 		{k.logsLineUp, k.logsLineDown, k.logsPageUp, k.logsPageDown, k.logsTop, k.logsBottom},
-		{k.logsSelect, k.logsCopy},
-		{k.openEditor, k.togglePin, k.toggleSplit},
-		{k.copyPath, k.copySnippet},
-		{k.cancelJob, k.focusChat, k.toggleLogs, k.toggleHelp, k.quit},
+		{k.logsSelect, k.logsCopy, k.logsFailure, k.logsClear, k.logsGrow, k.logsShrink},
+		{k.openEditor, k.togglePin, k.toggleSplit, k.toggleWrap, k.cycleTheme, k.colWiden, k.colNarrow},
+		{k.copyPath, k.copySnippet, k.copyPreview, k.findPreview, k.lineNumbers, k.reloadProj},
+		{k.cancelJob, k.rerunLast, k.navBack, k.navForward, k.focusChat, k.focusLogs, k.focusMode, k.credentials, k.toggleLogs, k.toggleHelp, k.quit},
+	}
+}
+
+// featureKeyBindings lists the keys each feature handles in handleGlobalKey
+// beyond the global keyMap. They feed the key help shown in the preview when
+// a feature opens without a selection, so a key added to or removed from a
+// feature case in handleGlobalKey must be updated here too.
+var featureKeyBindings = map[string][]key.Binding{
+	"tasks": {
+		key.NewBinding(key.WithKeys("f"), key.WithHelp("f", "cycle type filter")),
+		key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "cycle status filter")),
+		key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "cycle task status")),
+		key.NewBinding(key.WithKeys("v"), key.WithHelp("v", "epics only")),
+		key.NewBinding(key.WithKeys("M"), key.WithHelp("M", "raw/rendered markdown")),
+		key.NewBinding(key.WithKeys("ctrl+e", "E"), key.WithHelp("E", "export CSV")),
+		key.NewBinding(key.WithKeys("J"), key.WithHelp("J", "export JSON")),
+		key.NewBinding(key.WithKeys("Y"), key.WithHelp("Y", "copy as Jira markup")),
+		key.NewBinding(key.WithKeys("c"), key.WithHelp("c", "create-tasks")),
+		key.NewBinding(key.WithKeys("g"), key.WithHelp("g", "create-jira-tasks")),
+		key.NewBinding(key.WithKeys("m"), key.WithHelp("m", "migrate-tasks")),
+		key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "refine-tasks")),
+		key.NewBinding(key.WithKeys("w"), key.WithHelp("w", "work-on-tasks")),
+	},
+	"docs": {
+		key.NewBinding(key.WithKeys("M"), key.WithHelp("M", "raw/rendered markdown")),
+		key.NewBinding(key.WithKeys("D"), key.WithHelp("D", "diff against HEAD")),
+		key.NewBinding(key.WithKeys("B"), key.WithHelp("B", "diff against another base")),
+		key.NewBinding(key.WithKeys("T"), key.WithHelp("T", "table of contents")),
+	},
+	"generate": {
+		key.NewBinding(key.WithKeys("K"), key.WithHelp("K", "keep file")),
+		key.NewBinding(key.WithKeys("X"), key.WithHelp("X", "revert file")),
+		key.NewBinding(key.WithKeys("G"), key.WithHelp("G", "regenerate file")),
+	},
+	"database": {
+		key.NewBinding(key.WithKeys("O"), key.WithHelp("O", "open seed dump")),
+	},
+	"verify": {
+		key.NewBinding(key.WithKeys("Y"), key.WithHelp("Y", "copy check details")),
+	},
+	"services": {
+		key.NewBinding(key.WithKeys("u"), key.WithHelp("u", "run up")),
+		key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "run down")),
+		key.NewBinding(key.WithKeys("l"), key.WithHelp("l", "run logs")),
+		key.NewBinding(key.WithKeys("r", "R"), key.WithHelp("r", "refresh")),
+		key.NewBinding(key.WithKeys("o", "O"), key.WithHelp("o", "open endpoint")),
+		key.NewBinding(key.WithKeys("a", "A"), key.WithHelp("a", "open all healthy endpoints")),
+		key.NewBinding(key.WithKeys("1", "2", "3", "4", "5", "6", "7", "8", "9"), key.WithHelp("1-9", "open endpoint n")),
+	},
+	"tokens": {
+		key.NewBinding(key.WithKeys("-", "_"), key.WithHelp("-", "shorter range")),
+		key.NewBinding(key.WithKeys("=", "+"), key.WithHelp("=", "longer range")),
+		key.NewBinding(key.WithKeys("g", "G"), key.WithHelp("g", "toggle grouping")),
+		key.NewBinding(key.WithKeys("e", "E"), key.WithHelp("e", "export CSV")),
+	},
+	"reports": {
+		key.NewBinding(key.WithKeys("o", "O"), key.WithHelp("o", "open report")),
+		key.NewBinding(key.WithKeys("e", "E"), key.WithHelp("e", "export report")),
+		key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "copy path")),
+		key.NewBinding(key.WithKeys("Y"), key.WithHelp("Y", "copy snippet")),
+	},
+	"telemetry": {
+		key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter events")),
+		key.NewBinding(key.WithKeys("c"), key.WithHelp("c", "clear filter")),
+		key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "reload")),
+		key.NewBinding(key.WithKeys("o", "O"), key.WithHelp("o", "open log")),
+		key.NewBinding(key.WithKeys("X"), key.WithHelp("X", "clear log")),
+	},
+	"artifacts": {
+		key.NewBinding(key.WithKeys("m"), key.WithHelp("m", "mark for diff")),
+		key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "diff with marked")),
+	},
+	"env": {
+		key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "new key")),
+		key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "sort")),
+		key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "save")),
+		key.NewBinding(key.WithKeys("ctrl+z"), key.WithHelp("ctrl+z", "revert")),
+	},
+}
+
+// FeatureHelp returns the keys specific to feature: its own bindings followed
+// by the global bindings that only act inside it.
+func (k keyMap) FeatureHelp(feature string) []key.Binding {
+	bindings := append([]key.Binding{}, featureKeyBindings[feature]...)
+	switch feature {
+	case "docs", "generate", "database":
+		bindings = append([]key.Binding{k.openEditor}, bindings...)
+	case "artifacts":
+		bindings = append(bindings, k.openEditor, k.copyPath, k.copySnippet, k.toggleSplit, k.lineNumbers)
+	case "verify":
+		bindings = append(bindings, k.toggleSplit)
 	}
+	return bindings
 }
 
 type model struct {
@@ -489,7 +750,9 @@ type model struct {
 	servicesCol             *servicesTableColumn
 	tokensCol               *tokensTableColumn
 	reportsCol              *reportsTableColumn
+	telemetryCol            *telemetryTableColumn
 	artifactsCol            *selectableColumn
+	docTOCCol               *selectableColumn
 	artifactTreeCol         *artifactTreeColumn
 	previewCol              *previewColumn
 	logsCol                 *logsColumn
@@ -526,21 +789,32 @@ type model struct {
 	usingEnvLayout          bool
 	usingTokensLayout       bool
 	usingReportsLayout      bool
+	usingTelemetryLayout    bool
 	usingRfpEditor          bool
 	backlogCol              *backlogTreeColumn
 	backlogTable            *backlogTableColumn
 	rfpEditorPath           string
 
-	focus       int
-	hoverColumn int
+	focus             int
+	hoverColumn       int
+	columnWidthAdjust map[string][]int
 
 	showLogs            bool
+	logsHeight          int
+	previewWrap         bool
+	previewLineNumbers  bool
+	docsRawMarkdown     bool
+	docTOCVisible       bool
 	logsFocused         bool
+	logsReturnFocus     int
 	logs                viewport.Model
 	logLines            []string
 	logsSelectionActive bool
 	logsSelectionAnchor int
 	logsSelectionCursor int
+	logsFindQuery       string
+	logsFindMatches     []int
+	logsFindCurrent     int
 	logsPanelTop        int
 	logsPanelHeight     int
 
@@ -580,10 +854,23 @@ type model struct {
 	jobOrder        []int
 	jobRunningCount int
 
-	commandEntries   []paletteEntry
-	paletteMatches   []paletteEntry
-	paletteIndex     int
-	palettePaginator paginator.Model
+	commandEntries           []paletteEntry
+	discoveredCommands       []discoveredCommand
+	paletteMatches           []paletteEntry
+	paletteIndex             int
+	projectSearchEntries     []paletteEntry
+	projectSearchLoading     bool
+	templateEntries          []paletteEntry
+	recentFileEntries        []paletteEntry
+	featureVisibilityEntries []paletteEntry
+	recentFiles              map[string][]string
+	lastJob                  *jobRequest
+	lastJobEnv               []string
+	hiddenFeatures           map[string]bool
+	navHistory               navHistory
+	readOnly                 bool
+	themeEphemeral           bool
+	palettePaginator         paginator.Model
 
 	pinnedPaths             map[string]bool
 	uiConfig                *uiConfig
@@ -599,21 +886,43 @@ type model struct {
 	servicesPolling         bool
 	servicesTimer           timer.Model
 	servicesTimerActive     bool
+	dashboardTimer          timer.Model
+	dashboardTimerActive    bool
+	servicesComposePath     string
+	servicesComposeMissing  bool
 	dockerAvailable         bool
+	dockerUnavailableReason string
+	dockerCheckActive       bool
 	seenProjects            map[string]bool
 	createProjectJobs       map[string]string
 	lastProjectRefresh      map[string]time.Time
 	jobProjectPaths         map[string]string
+	projectFeatures         map[string]projectFeatureState
+	columnCursors           map[string]int
 
 	toastMessage string
 	toastExpires time.Time
 
+	// lastFailureLogIndex points at the newest failure line; it stays
+	// revealable until a later job succeeds or the log is cleared.
+	lastFailureLogIndex int
+	lastFailurePending  bool
+
 	pendingNewProjectPath     string
 	pendingNewProjectTemplate string
+	pendingConfirmRun         func() tea.Cmd
+	pendingConfirmTitle       string
+	nextJobEnv                []string
+
+	sessionCommands []sessionCommand
+
+	diagnosticsActive  bool
+	diagnosticsVersion string
 
 	currentDocRelPath       string
 	currentDocDiffBase      string
 	currentDocType          string
+	docDiffRevision         string
 	lastDocTelemetryKey     string
 	currentVerifyCheck      string
 	lastVerifyPreviewKey    string
@@ -629,6 +938,8 @@ type model struct {
 	currentArtifactKey      string
 	currentArtifactRel      string
 	artifactSplit           artifactSplitState
+	artifactDiffMark        string
+	verifySplit             bool
 
 	suppressPipelineTelemetry bool
 
@@ -649,9 +960,17 @@ type model struct {
 	backlogStatusFilter  backlogStatusFilter
 	backlogScope         backlogNode
 	backlogActive        backlogNode
+	backlogEpicsOnly     bool
+	backlogRawMarkdown   bool
+	focusMode            bool
+	focusModeLogs        bool
 	selectedEpics        map[string]bool
 	pendingBacklogReason string
 	credentialHint       string
+	credentialMissing    []string
+	credentialBadgeStart int
+	credentialBadgeEnd   int
+	statusContentRow     int
 
 	tokensUsage         *tokensUsage
 	tokensViewData      tokensViewData
@@ -662,33 +981,61 @@ type model struct {
 	tokensError         error
 	tokensTelemetrySent bool
 
-	reportEntries        []reportEntry
-	currentReportKey     string
-	reportsLoading       bool
-	reportsError         error
-	reportsTelemetrySent bool
-	settingsConcurrency  int
-	settingsDockerPath   string
-	customWorkspaceRoots []string
-	updateStatus         string
-	updateLastError      string
-	updateLastRun        time.Time
+	reportEntries            []reportEntry
+	currentReportKey         string
+	reportsLoading           bool
+	reportsError             error
+	reportsTelemetrySent     bool
+	telemetryEvents          []telemetryEvent
+	telemetryFilter          string
+	telemetryLoading         bool
+	telemetryClearArmed      time.Time
+	codexLogTicking          bool
+	codexLogStamp            string
+	settingsConcurrency      int
+	settingsServicesPoll     int
+	settingsDashboardRefresh int
+	settingsLogLines         int
+	settingsTelemetry        bool
+	settingsDryRun           bool
+	settingsAutoWatch        bool
+	settingsAutoVerify       bool
+	settingsInlineImages     bool
+	settingsDenseLists       bool
+	imageProtocol            inlineImageProtocol
+	inlineImages             map[uint32]kittyImage
+	pendingImageTransmits    []tea.Cmd
+	autoVerifyPending        string
+	columnArrangement        columnArrangement
+	rootWatcher              *rootWatcher
+	rootChanges              chan rootChangedMsg
+	artifactWatcher          *fileWatcher
+	artifactChanges          chan fileChangedMsg
+	confirmCommands          []string
+	settingsDockerPath       string
+	customWorkspaceRoots     []string
+	updateStatus             string
+	updateLastError          string
+	updateLastRun            time.Time
 
 	jobStopwatch    stopwatch.Model
 	jobTimingActive bool
 	jobTimingTitle  string
 	jobLastDuration time.Duration
+	jobDurations    jobDurationHistory
 }
 
 func initialModel() *model {
 	s := newStyles()
 	m := &model{
-		styles:        s,
-		keys:          newKeyMap(),
-		help:          help.New(),
-		markdownTheme: currentMarkdownTheme(),
-		hoverColumn:   -1,
-		showLogs:      true,
+		styles:          s,
+		keys:            newKeyMap(),
+		help:            help.New(),
+		markdownTheme:   currentMarkdownTheme(),
+		hoverColumn:     -1,
+		showLogs:        true,
+		rootChanges:     make(chan rootChangedMsg),
+		artifactChanges: make(chan fileChangedMsg),
 	}
 
 	m.logLines = []string{
@@ -702,13 +1049,7 @@ func initialModel() *model {
 	m.logsPanelHeight = 0
 
 	m.help.ShortSeparator = " │ "
-	m.help.Styles.ShortKey = m.styles.statusHint.Copy()
-	m.help.Styles.ShortDesc = m.styles.statusHint.Copy()
-	m.help.Styles.ShortSeparator = m.styles.statusSeg.Copy()
-	m.help.Styles.Ellipsis = m.styles.statusSeg.Copy()
-	m.help.Styles.FullKey = m.styles.statusHint.Copy()
-	m.help.Styles.FullDesc = m.styles.statusHint.Copy()
-	m.help.Styles.FullSeparator = m.styles.statusSeg.Copy()
+	m.applyThemeStyles(m.markdownTheme)
 
 	m.help.ShowAll = true
 
@@ -717,7 +1058,8 @@ func initialModel() *model {
 	m.inputField.CharLimit = 256
 	m.inputArea = textarea.New()
 	m.inputArea.Prompt = ""
-	m.inputArea.CharLimit = 4096
+	m.inputArea.CharLimit = textareaCharLimit
+	m.inputArea.MaxHeight = textareaMaxHeight
 	m.inputArea.ShowLineNumbers = false
 	m.inputArea.SetHeight(6)
 	m.inputArea.SetWidth(48)
@@ -747,16 +1089,27 @@ func initialModel() *model {
 	m.palettePaginator.TotalPages = 1
 	m.jobRunner = newJobManager()
 	m.jobStatuses = make(map[int]*jobStatus)
+	m.lastFailureLogIndex = -1
 	m.jobOrder = nil
 	m.seenProjects = make(map[string]bool)
 	m.pinnedPaths = make(map[string]bool)
 	m.createProjectJobs = make(map[string]string)
 	m.lastProjectRefresh = make(map[string]time.Time)
 	m.jobProjectPaths = make(map[string]string)
+	m.projectFeatures = make(map[string]projectFeatureState)
+	m.columnCursors = make(map[string]int)
+	m.imageProtocol = detectInlineImageProtocol()
+	m.inlineImages = make(map[uint32]kittyImage)
 	m.selectedEpics = make(map[string]bool)
 	m.artifactExplorers = make(map[string]*artifactExplorer)
 	m.backlogFilterType = backlogTypeFilterAll
 	m.backlogStatusFilter = backlogStatusFilterAll
+	m.settingsServicesPoll = defaultServicesPollSeconds
+	m.settingsLogLines = defaultLogLines
+	m.settingsTelemetry = true
+	m.confirmCommands = append([]string(nil), defaultConfirmCommands...)
+	m.logsHeight = logsColumnHeight
+	m.columnWidthAdjust = make(map[string][]int)
 	store, err := openWorkspaceStore()
 	if err != nil {
 		m.appendLog(fmt.Sprintf("Workspace store unavailable: %v", err))
@@ -779,10 +1132,45 @@ func initialModel() *model {
 			selected := markdownThemeFromString(theme)
 			m.markdownTheme = selected
 			setMarkdownTheme(selected)
+			m.applyThemeStyles(selected)
 		}
 		if cfg.Concurrency > 0 {
 			m.settingsConcurrency = cfg.Concurrency
 		}
+		if cfg.ServicesPoll != nil && *cfg.ServicesPoll >= 0 {
+			m.settingsServicesPoll = min(*cfg.ServicesPoll, maxServicesPollSeconds)
+		}
+		m.settingsDashboardRefresh = min(max(cfg.DashboardRefresh, 0), maxDashboardRefreshSeconds)
+		if cfg.LogLines > 0 {
+			m.settingsLogLines = min(max(cfg.LogLines, minLogLines), maxLogLines)
+		}
+		m.previewWrap = cfg.PreviewWrap
+		m.previewLineNumbers = cfg.LineNumbers
+		m.docsRawMarkdown = cfg.DocsRaw
+		if cfg.Telemetry != nil {
+			m.settingsTelemetry = *cfg.Telemetry
+		}
+		m.settingsDryRun = cfg.DryRun
+		m.settingsAutoWatch = cfg.AutoWatch
+		m.settingsAutoVerify = cfg.AutoVerify
+		m.settingsInlineImages = cfg.InlineImages
+		m.settingsDenseLists = cfg.DenseLists
+		m.recentFiles = cfg.RecentFiles
+		m.hiddenFeatures = hiddenFeatureSet(cfg.HiddenFeatures)
+		m.columnArrangement = columnArrangementFromString(cfg.ColumnLayout)
+		if cfg.ConfirmCommands != nil {
+			m.confirmCommands = parseConfirmCommands(strings.Join(*cfg.ConfirmCommands, ","))
+		}
+		if cfg.LogsHeight > 0 {
+			m.logsHeight = min(max(cfg.LogsHeight, minLogsColumnHeight), maxLogsColumnHeight)
+		}
+		for kind, adjust := range cfg.ColumnWidths {
+			clamped := make([]int, len(adjust))
+			for i, delta := range adjust {
+				clamped[i] = min(max(delta, -maxColumnWidthAdjust), maxColumnWidthAdjust)
+			}
+			m.columnWidthAdjust[kind] = clamped
+		}
 		m.settingsDockerPath = strings.TrimSpace(cfg.DockerPath)
 		for _, path := range cfg.WorkspaceRoots {
 			clean := filepath.Clean(strings.TrimSpace(path))
@@ -832,7 +1220,14 @@ func initialModel() *model {
 	m.telemetrySessionID = sessionID
 	m.telemetryUserID = userID
 	m.telemetrySessionStarted = sessionStart
-	m.telemetry = newTelemetryLogger(filepath.Join(resolveConfigDir(), "ui-events.ndjson"), sessionID, userID)
+	if err := migrateStateFiles(); err != nil {
+		m.appendLog(fmt.Sprintf("Failed to move telemetry log to %s: %v", abbreviatePath(resolveStateDir()), err))
+	}
+	m.telemetry = newTelemetryLogger(telemetryLogPath(), sessionID, userID)
+	m.telemetry.SetEnabled(m.settingsTelemetry)
+	if m.uiConfig != nil && m.uiConfig.TelemetryMaxMB > 0 {
+		m.telemetry.SetMaxBytes(int64(m.uiConfig.TelemetryMaxMB) << 20)
+	}
 	m.pipelineStepMarks = make(map[string]map[string]time.Time)
 	m.verifyCheckStatus = make(map[string]map[string]string)
 	m.serviceHealth = make(map[string]string)
@@ -901,6 +1296,18 @@ func initialModel() *model {
 	m.artifactsCol.SetDebugLogger(m.appendDebugLog)
 	m.artifactsCol.ApplyStyles(m.styles)
 
+	m.docTOCCol = newSelectableColumn("Contents", nil, 41, func(entry listEntry) tea.Cmd {
+		m.scrollDocToHeading(entry)
+		m.setFocusArea(focusPreview)
+		return nil
+	})
+	m.docTOCCol.SetHighlightFunc(func(entry listEntry) tea.Cmd {
+		m.scrollDocToHeading(entry)
+		return nil
+	})
+	m.docTOCCol.ApplyStyles(m.styles)
+	m.applyDenseLists()
+
 	m.envTableCol = newEnvTableColumn("Variables")
 	m.envTableCol.SetOnEdit(func(entry envEntry) tea.Cmd {
 		m.promptEnvValueEdit(entry)
@@ -962,6 +1369,12 @@ func initialModel() *model {
 	})
 	m.reportsCol.ApplyStyles(m.styles)
 
+	m.telemetryCol = newTelemetryTableColumn("Telemetry")
+	m.telemetryCol.SetHighlightFunc(func(event telemetryEvent) tea.Cmd {
+		return func() tea.Msg { return telemetryRowSelectedMsg{event: event} }
+	})
+	m.telemetryCol.ApplyStyles(m.styles)
+
 	m.backlogCol = newBacklogTreeColumn("Epics/Stories/Tasks")
 	m.backlogCol.SetCallbacks(
 		m.backlogHighlightCmd,
@@ -992,6 +1405,7 @@ func initialModel() *model {
 
 	m.previewCol = newPreviewColumn(32)
 	m.previewCol.SetContent("Select an item to preview details.\n")
+	m.previewCol.SetWrap(m.previewWrap)
 	m.previewCol.ApplyStyles(m.styles)
 	m.applyMarkdownTheme(m.markdownTheme, false)
 
@@ -1040,6 +1454,9 @@ func initialModel() *model {
 	}
 	m.updateVisibleColumns()
 
+	m.updateCredentialHint()
+	m.discoveredCommands = loadCachedCLICommands()
+	m.jobDurations = loadJobDurationHistory()
 	m.refreshCommandCatalog()
 	m.refreshChatView()
 
@@ -1047,11 +1464,15 @@ func initialModel() *model {
 }
 
 func (m *model) Init() tea.Cmd {
-	return m.spinner.Tick
+	return tea.Batch(m.spinner.Tick, waitForRootChange(m.rootChanges), waitForFileChange(m.artifactChanges))
 }
 
-func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+func (m *model) Update(msg tea.Msg) (_ tea.Model, next tea.Cmd) {
 	var cmds []tea.Cmd
+	defer m.recordNavigation()
+	defer func() {
+		next = m.flushImageTransmits(next)
+	}()
 
 	if tick, ok := msg.(spinner.TickMsg); ok {
 		var cmd tea.Cmd
@@ -1087,6 +1508,17 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.handleOverlayCloseMouse(mouseMsg) {
 			return m, tea.Batch(cmds...)
 		}
+		if !m.overlayActive && mouseMsg.Type == tea.MouseLeft && mouseMsg.Y == m.statusContentRow &&
+			mouseMsg.X >= m.credentialBadgeStart && mouseMsg.X < m.credentialBadgeEnd {
+			cmds = append(cmds, m.openCredentialsEditor())
+			return m, tea.Batch(cmds...)
+		}
+	}
+
+	// The scan finishes while the project search overlay holds the input.
+	if loaded, ok := msg.(projectSearchLoadedMsg); ok {
+		m.handleProjectSearchLoaded(loaded)
+		return m, tea.Batch(cmds...)
 	}
 
 	if m.removeWorkspaceConfirmActive {
@@ -1132,10 +1564,19 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				m.closeQuitConfirm()
 				return m, tea.Batch(cmds...)
-			case "esc":
+			case "esc", "n", "N":
+				m.closeQuitConfirm()
+				return m, tea.Batch(cmds...)
+			case "y", "Y", "ctrl+c":
 				m.closeQuitConfirm()
+				cmds = append(cmds, tea.Quit)
 				return m, tea.Batch(cmds...)
-			case "q", "ctrl+c":
+			case "q":
+				// With jobs in flight a repeated q must not kill them; the
+				// user has to answer y or force-quit with ctrl+c.
+				if m.jobRunner.ActiveCount() > 0 {
+					return m, tea.Batch(cmds...)
+				}
 				m.closeQuitConfirm()
 				cmds = append(cmds, tea.Quit)
 				return m, tea.Batch(cmds...)
@@ -1161,13 +1602,36 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, cmd)
 		}
 	}
+	if tickMsg, ok := msg.(timer.TickMsg); ok && m.dashboardTimerActive {
+		var cmd tea.Cmd
+		m.dashboardTimer, cmd = m.dashboardTimer.Update(tickMsg)
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	if startStopMsg, ok := msg.(timer.StartStopMsg); ok && m.dashboardTimerActive {
+		var cmd tea.Cmd
+		m.dashboardTimer, cmd = m.dashboardTimer.Update(startStopMsg)
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	if timeoutMsg, ok := msg.(timer.TimeoutMsg); ok && m.dashboardTimerActive && timeoutMsg.ID == m.dashboardTimer.ID() {
+		m.dashboardTimerActive = false
+		if cmd := m.reloadDashboardCmd(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+		if cmd := m.startDashboardRefresh(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
 	if timeoutMsg, ok := msg.(timer.TimeoutMsg); ok && m.servicesTimerActive && timeoutMsg.ID == m.servicesTimer.ID() {
 		m.servicesTimerActive = false
 		if cmd := m.loadServicesCmd(); cmd != nil {
 			cmds = append(cmds, cmd)
 		}
-		if m.servicesPolling {
-			m.servicesTimer = timer.NewWithInterval(servicesPollInterval, time.Second)
+		if m.servicesPolling && m.settingsServicesPoll > 0 {
+			m.servicesTimer = timer.NewWithInterval(m.servicesPollInterval(), time.Second)
 			m.servicesTimerActive = true
 			if cmd := m.servicesTimer.Init(); cmd != nil {
 				cmds = append(cmds, cmd)
@@ -1285,7 +1749,7 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(cmds...)
 		}
 
-		if m.inputMode == inputCommandPalette {
+		if m.inputUsesPaletteList() {
 			m.palettePaginator, _ = m.palettePaginator.Update(msg)
 			m.configurePalettePaginator()
 			if keyMsg, ok := msg.(tea.KeyMsg); ok {
@@ -1342,7 +1806,7 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if cmd != nil {
 			cmds = append(cmds, cmd)
 		}
-		if m.inputMode == inputCommandPalette {
+		if m.inputUsesPaletteList() {
 			m.updatePaletteMatches(m.inputField.Value())
 		}
 		return m, tea.Batch(cmds...)
@@ -1471,7 +1935,9 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, cmd)
 		}
 	case servicesLoadedMsg:
-		m.handleServicesLoaded(message.items)
+		m.handleServicesLoaded(message)
+	case endpointOpenMsg:
+		m.handleEndpointOpen(message)
 	case backlogLoadedMsg:
 		m.handleBacklogLoaded(message)
 	case backlogNodeHighlightedMsg:
@@ -1493,7 +1959,31 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, cmd)
 		}
 	case reportsRowSelectedMsg:
-		m.handleReportsRowSelected(message)
+		if cmd := m.handleReportsRowSelected(message); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	case codexLogTickMsg:
+		if cmd := m.handleCodexLogTick(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	case rootChangedMsg:
+		m.handleRootChanged(message)
+		cmds = append(cmds, waitForRootChange(m.rootChanges))
+	case fileChangedMsg:
+		m.handleArtifactFileChanged(message)
+		cmds = append(cmds, waitForFileChange(m.artifactChanges))
+	case editorExitedMsg:
+		m.handleEditorExited(message)
+	case cliVersionMsg:
+		m.handleCLIVersion(message)
+	case dockerReadinessMsg:
+		m.handleDockerReadiness(message.readiness)
+	case cliCommandsDiscoveredMsg:
+		m.handleCLICommandsDiscovered(message)
+	case telemetryLoadedMsg:
+		m.handleTelemetryLoaded(message)
+	case telemetryRowSelectedMsg:
+		m.previewCol.SetContent(renderTelemetryPreview(message.event))
 	case tokensLoadedMsg:
 		if cmd := m.handleTokensLoaded(message); cmd != nil {
 			cmds = append(cmds, cmd)
@@ -1502,6 +1992,11 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.handleTokensRowSelected(message.row)
 	case tokensExportedMsg:
 		m.handleTokensExported(message)
+	case inlineImageSentMsg:
+		if message.err != nil {
+			m.appendDebugLog("inline image transmit: %v", message.err)
+			delete(m.inlineImages, message.id)
+		}
 	}
 
 	m.applyLayout()
@@ -1530,8 +2025,8 @@ func (m *model) View() string {
 	builder.WriteRune('\n')
 
 	var colViews []string
-	for i, col := range m.columns {
-		colViews = append(colViews, col.View(m.styles, i == m.focus))
+	for _, i := range m.columnDisplayOrder() {
+		colViews = append(colViews, m.columns[i].View(m.styles, i == m.focus))
 	}
 	row := lipgloss.JoinHorizontal(lipgloss.Top, colViews...)
 	builder.WriteString(m.renderColumnsRow(row))
@@ -1574,7 +2069,7 @@ func (m *model) View() string {
 		if fillerWidth > 0 {
 			filler := lipgloss.NewStyle().
 				Width(fillerWidth).
-				Height(logsColumnHeight).
+				Height(m.logsHeight).
 				Background(crushBackground).
 				Render("")
 			logView = lipgloss.JoinHorizontal(lipgloss.Top, filler, logView)
@@ -1584,6 +2079,7 @@ func (m *model) View() string {
 	}
 
 	status := m.renderStatus()
+	m.statusContentRow = strings.Count(builder.String(), "\n") + m.styles.statusBar.GetBorderTopSize()
 	builder.WriteString(status)
 
 	m.overlayActive = false
@@ -1686,6 +2182,9 @@ func (m *model) View() string {
 				contentBuilder.WriteRune('\n')
 			}
 			hintParts := []string{"enter select", "ctrl+t manual entry", "esc cancel"}
+			if m.filePickerAllowDirs && m.filePickerAllowFiles {
+				hintParts = []string{"enter select", "→ open folder", "ctrl+t manual entry", "esc cancel"}
+			}
 			contentBuilder.WriteString(m.styles.cmdHint.Render(strings.Join(hintParts, " • ")))
 		} else if m.textAreaEnabled {
 			areaWidth := overlayWidth - 4
@@ -1707,10 +2206,10 @@ func (m *model) View() string {
 			m.inputArea.SetHeight(areaHeight)
 			contentBuilder.WriteString(m.inputArea.View())
 			contentBuilder.WriteRune('\n')
-			contentBuilder.WriteString(m.styles.cmdHint.Render("ctrl+enter save • esc cancel"))
+			contentBuilder.WriteString(m.styles.cmdHint.Render(ternary(m.inputMode == inputNotes, "ctrl+s save • esc save & close", "ctrl+enter save • esc cancel")))
 		} else {
 			contentBuilder.WriteString(m.inputField.View())
-			if m.inputMode == inputCommandPalette && len(m.paletteMatches) > 0 {
+			if m.inputUsesPaletteList() && (len(m.paletteMatches) > 0 || m.projectSearchLoading) {
 				contentBuilder.WriteString("\n\n")
 				contentBuilder.WriteString(m.renderPaletteMatches(overlayWidth))
 			}
@@ -1718,6 +2217,12 @@ func (m *model) View() string {
 			switch m.inputMode {
 			case inputCommandPalette:
 				hintParts = []string{"tab cycle", "enter run", "esc close", "←/→ page"}
+			case inputProjectSearch, inputRecentFiles:
+				hintParts = []string{"tab cycle", "enter open", "esc close", "←/→ page"}
+			case inputNewProjectTemplate:
+				hintParts = []string{"type to filter or name a template", "enter choose", "esc cancel"}
+			case inputSettingsFeatures:
+				hintParts = []string{"enter show/hide", "esc done"}
 			default:
 				if m.inputMode == inputAddRoot || m.inputMode == inputAttachRFP {
 					hintParts = append(hintParts, "ctrl+t file picker")
@@ -2201,7 +2706,7 @@ func (m *model) View() string {
 		m.overlayCloseLabel = closeLabel
 
 		contentBuilder.WriteRune('\n')
-		contentBuilder.WriteString(m.styles.confirmMessage.Render("Quit gpt-creator?"))
+		contentBuilder.WriteString(m.styles.confirmMessage.Render(m.quitConfirmMessage()))
 		contentBuilder.WriteString("\n\n")
 		cancel := m.styles.confirmButton.Render("Cancel")
 		if m.quitConfirmIndex == 0 {
@@ -2214,7 +2719,7 @@ func (m *model) View() string {
 		buttons := lipgloss.JoinHorizontal(lipgloss.Left, cancel, "  ", confirm)
 		contentBuilder.WriteString(buttons)
 		contentBuilder.WriteRune('\n')
-		hint := m.styles.cmdHint.Render("←/→ choose • enter confirm • esc cancel • q quit")
+		hint := m.styles.cmdHint.Render("←/→ choose • enter confirm • y quit • n/esc cancel • ctrl+c force quit")
 		contentBuilder.WriteString(hint)
 
 		overlayContent := strings.TrimRight(contentBuilder.String(), "\n")
@@ -2444,7 +2949,22 @@ func (m *model) renderHeader() string {
 ███████▓▓▓▓▓▒▒▒░ v․₀․₂․₀ ░▒▒▓█
 `
 	logo := m.styles.headerLogo.Render(strings.TrimPrefix(logoArt, "\n"))
-	return strings.TrimRight(logo, "\n")
+	logo = strings.TrimRight(logo, "\n")
+	if m.readOnly {
+		badge := m.styles.statusSeg.Copy().Foreground(crushDebug).Bold(true).Render("READ-ONLY")
+		logo = lipgloss.JoinHorizontal(lipgloss.Bottom, logo, "  ", badge)
+	}
+	return logo
+}
+
+// rejectInReadOnly reports whether read-only mode blocks an action that would
+// run a command or write project files, and tells the user so.
+func (m *model) rejectInReadOnly() bool {
+	if !m.readOnly {
+		return false
+	}
+	m.setToast("Read-only mode", 4*time.Second)
+	return true
 }
 
 func (m *model) renderHeaderPanel() (string, int) {
@@ -2457,6 +2977,9 @@ func (m *model) renderHeaderPanel() (string, int) {
 	return panel, lipgloss.Height(panel)
 }
 
+// handleGlobalKey handles keys that are not consumed by an overlay. The
+// per-feature cases below are documented in featureKeyBindings, which drives
+// the feature key help; keep the two in sync when changing a feature's keys.
 func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 	if m.usingRfpEditor {
 		if area, ok := m.focusedArea(); ok && area == focusItems {
@@ -2466,11 +2989,31 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 	if handled, cmd := m.handleLogsKey(msg); handled {
 		return true, cmd
 	}
+	if m.diagnosticsActive && msg.String() == "y" {
+		if area, ok := m.focusedArea(); ok && area == focusPreview {
+			m.copyDiagnostics()
+			return true, nil
+		}
+	}
 	if m.currentFeature == "settings" {
 		if handled, cmd := m.handleSettingsKey(msg); handled {
 			return true, cmd
 		}
 	}
+	if m.currentFeature == "generate" {
+		if area, ok := m.focusedArea(); ok && (area == focusItems || area == focusPreview) {
+			switch msg.String() {
+			case "K":
+				m.keepSelectedGenerateFile()
+				return true, nil
+			case "X":
+				m.confirmRevertGenerateFile()
+				return true, nil
+			case "G":
+				return true, m.regenerateCurrentGenerateFile()
+			}
+		}
+	}
 	if m.currentFeature == "services" {
 		if area, ok := m.focusedArea(); ok {
 			switch area {
@@ -2482,9 +3025,14 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 					return true, m.runServiceCommand("run-down")
 				case "l":
 					return true, m.runServiceCommand("run-logs")
+				case "r", "R":
+					m.setToast("Refreshing services…", 3*time.Second)
+					return true, m.loadServicesCmd()
 				case "o", "O":
 					m.openSelectedServiceEndpoint(-1)
 					return true, nil
+				case "a", "A":
+					return true, m.openAllServiceEndpoints()
 				default:
 					if idx := parseServiceEndpointIndex(msg.String()); idx >= 0 {
 						m.openSelectedServiceEndpoint(idx)
@@ -2521,8 +3069,7 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 	if m.currentFeature == "reports" {
 		switch msg.String() {
 		case "o", "O":
-			m.openSelectedReport()
-			return true, nil
+			return true, m.openSelectedReport()
 		case "e", "E":
 			if cmd := m.exportSelectedReport(); cmd != nil {
 				return true, cmd
@@ -2536,6 +3083,34 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 			return true, nil
 		}
 	}
+	if m.currentFeature == "telemetry" {
+		switch msg.String() {
+		case "/":
+			if colAny, ok := m.focusedColumn(); ok {
+				if _, isList := colAny.(*selectableColumn); isList {
+					break
+				}
+			}
+			m.openInput("Filter telemetry events", m.telemetryFilter, inputTelemetryFilter)
+			return true, nil
+		case "c":
+			if m.telemetryFilter != "" {
+				m.telemetryFilter = ""
+				m.applyTelemetryFilter()
+				m.setToast("Telemetry filter cleared", 3*time.Second)
+			}
+			return true, nil
+		case "r":
+			return true, m.reloadTelemetryEvents()
+		case "o", "O":
+			return true, m.openTelemetryLog()
+		case "X":
+			return true, m.clearTelemetryLog()
+		}
+	}
+	if handled, cmd := m.handlePreviewFindKey(msg); handled {
+		return true, cmd
+	}
 	switch {
 	case msg.String() == "H":
 		if m.scrollFocusedColumn(-horizontalScrollStep) {
@@ -2561,7 +3136,7 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 		return true, nil
 	case key.Matches(msg, m.keys.prevFocus):
 		if m.logsFocused {
-			indices := m.focusableColumnIndices()
+			indices := m.focusOrder()
 			if len(indices) > 0 {
 				m.setFocusIndex(indices[len(indices)-1])
 			}
@@ -2592,9 +3167,66 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 		m.applyLayout()
 		m.clampFocusAfterLayout()
 		return true, nil
+	case key.Matches(msg, m.keys.logsClear):
+		m.clearLogs()
+		return true, nil
+	case key.Matches(msg, m.keys.logsFailure):
+		m.revealLastFailure()
+		return true, nil
+	case key.Matches(msg, m.keys.toggleWrap):
+		m.togglePreviewWrap()
+		return true, nil
+	case key.Matches(msg, m.keys.lineNumbers):
+		m.togglePreviewLineNumbers()
+		return true, nil
+	case key.Matches(msg, m.keys.cycleTheme):
+		// Inputs use ctrl+t to toggle the file picker; that path returns
+		// before global keys, but text editors also land here.
+		if m.textEntryActive() {
+			return false, nil
+		}
+		m.cycleThemeSetting(1)
+		return true, nil
+	case key.Matches(msg, m.keys.copyPreview):
+		if area, ok := m.focusedArea(); ok && area == focusPreview {
+			m.copyPreviewContent()
+			return true, nil
+		}
+	case key.Matches(msg, m.keys.reloadProj):
+		return true, m.reloadCurrentProject()
+	case key.Matches(msg, m.keys.colWiden):
+		m.resizeFocusedColumn(columnWidthStep)
+		return true, nil
+	case key.Matches(msg, m.keys.colNarrow):
+		m.resizeFocusedColumn(-columnWidthStep)
+		return true, nil
+	case key.Matches(msg, m.keys.logsGrow):
+		m.resizeLogsPanel(2)
+		return true, nil
+	case key.Matches(msg, m.keys.logsShrink):
+		m.resizeLogsPanel(-2)
+		return true, nil
 	case key.Matches(msg, m.keys.focusChat):
 		m.focusChatInput()
 		return true, nil
+	case key.Matches(msg, m.keys.focusLogs):
+		if m.logsFocused {
+			m.returnFocusFromLogs()
+		} else {
+			m.focusLogsFromColumns()
+		}
+		return true, nil
+	case key.Matches(msg, m.keys.focusMode):
+		m.toggleFocusMode()
+		return true, nil
+	case key.Matches(msg, m.keys.credentials):
+		return true, m.openCredentialsEditor()
+	case key.Matches(msg, m.keys.rerunLast):
+		return true, m.rerunLastJob()
+	case key.Matches(msg, m.keys.navBack):
+		return true, m.stepNavigation(-1)
+	case key.Matches(msg, m.keys.navForward):
+		return true, m.stepNavigation(1)
 	case key.Matches(msg, m.keys.cancelJob):
 		cmd := m.cancelActiveJob()
 		return true, cmd
@@ -2611,21 +3243,26 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 			return true, nil
 		}
 		return true, nil
+	case key.Matches(msg, m.keys.jumpProject):
+		if !m.inputActive {
+			return true, m.openProjectSearch()
+		}
+		return true, nil
 	case key.Matches(msg, m.keys.openEditor):
 		if area, ok := m.focusedArea(); ok {
 			switch area {
 			case focusWorkspace:
-				m.openProjectInEditor()
+				return true, m.openProjectInEditor()
 			case focusItems, focusPreview:
 				switch m.currentFeature {
 				case "docs":
-					m.openCurrentDocInEditor()
+					return true, m.openCurrentDocInEditor()
 				case "generate":
-					m.openCurrentGenerateFileInEditor()
+					return true, m.openCurrentGenerateFileInEditor()
 				case "database":
-					m.openDatabaseDumpInEditor("schema")
+					return true, m.openDatabaseDumpInEditor("schema")
 				case "artifacts":
-					m.openCurrentArtifactInEditor()
+					return true, m.openCurrentArtifactInEditor()
 				}
 			}
 		}
@@ -2636,6 +3273,10 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 		}
 		return true, nil
 	case key.Matches(msg, m.keys.copyPath):
+		if area, ok := m.focusedArea(); ok && area == focusWorkspace {
+			m.copySelectedWorkspacePath()
+			return true, nil
+		}
 		if m.currentFeature == "artifacts" {
 			m.copyCurrentArtifactPath()
 			return true, nil
@@ -2645,11 +3286,19 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 			m.copyCurrentArtifactSnippet()
 			return true, nil
 		}
+		if m.currentFeature == "verify" {
+			m.copyVerifyCheckDetails()
+			return true, nil
+		}
 	case key.Matches(msg, m.keys.toggleSplit):
 		if m.currentFeature == "artifacts" {
 			m.toggleArtifactSplit()
 			return true, nil
 		}
+		if m.currentFeature == "verify" {
+			m.toggleVerifySplit()
+			return true, nil
+		}
 	}
 
 	if m.currentFeature == "env" && m.usingEnvLayout {
@@ -2657,17 +3306,22 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 		case "ctrl+s":
 			m.saveCurrentEnvFile()
 			return true, nil
+		case "ctrl+z":
+			m.confirmRevertEnvFile()
+			return true, nil
 		case "n":
 			m.promptEnvNewEntry()
 			return true, nil
+		case "s":
+			m.sortCurrentEnvFile()
+			return true, nil
 		}
 	}
 
 	switch msg.String() {
 	case "O":
 		if area, ok := m.focusedArea(); ok && (area == focusPreview || area == focusItems) && m.currentFeature == "database" {
-			m.openDatabaseDumpInEditor("seed")
-			return true, nil
+			return true, m.openDatabaseDumpInEditor("seed")
 		}
 	case "/":
 		if colAny, ok := m.focusedColumn(); ok {
@@ -2699,14 +3353,20 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 			if len(m.currentItem.Command) > 0 {
 				return true, m.runCurrentItemCommand()
 			}
+			if m.currentFeature == "verify" && m.currentItem.Key == "verify-rerun-failed" {
+				return true, m.rerunFailedVerifyChecks()
+			}
+			if m.currentFeature == "overview" && m.currentItem.Key == "overview-export" {
+				m.exportOverviewBrief()
+				return true, nil
+			}
 			if m.currentFeature == "docs" {
 				if handled, cmd := m.handleDocsPreviewEnter(); handled {
 					return true, cmd
 				}
 			}
 			if m.currentFeature == "reports" {
-				m.openSelectedReport()
-				return true, nil
+				return true, m.openSelectedReport()
 			}
 			return true, nil
 		}
@@ -2736,9 +3396,19 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 		}
 		return false, nil
 	case "esc":
+		if colAny, ok := m.focusedColumn(); ok {
+			if col, ok := colAny.(*selectableColumn); ok && col.FilterActive() {
+				return false, nil
+			}
+		}
 		m.stepBack()
 		return true, nil
 	case "backspace":
+		if colAny, ok := m.focusedColumn(); ok {
+			if col, ok := colAny.(*selectableColumn); ok && col.model.SettingFilter() {
+				return false, nil
+			}
+		}
 		m.stepBack()
 		return true, nil
 	}
@@ -2756,6 +3426,20 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 		case "ctrl+e", "E":
 			m.runBacklogExport()
 			return true, nil
+		case "J":
+			m.runBacklogJSONExport()
+			return true, nil
+		case "Y":
+			m.copyBacklogItemAsJira()
+			return true, nil
+		case "t":
+			return true, m.cycleBacklogTaskStatus()
+		case "v":
+			m.toggleBacklogEpicsOnly()
+			return true, nil
+		case "M":
+			m.toggleBacklogRawMarkdown()
+			return true, nil
 		case "g":
 			return true, m.queueTasksCommand([]string{"create-jira-tasks"})
 		case "m":
@@ -2768,6 +3452,44 @@ func (m *model) handleGlobalKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 			return true, m.queueTasksCommand([]string{"work-on-tasks"})
 		}
 	}
+	if m.currentFeature == "docs" {
+		switch msg.String() {
+		case "M":
+			m.toggleDocsRawMarkdown()
+			return true, nil
+		case "D":
+			m.showDocRevisionDiff("HEAD")
+			return true, nil
+		case "B":
+			m.promptDocDiffBase()
+			return true, nil
+		case "T":
+			m.toggleDocTOC()
+			return true, nil
+		}
+	}
+	if m.currentFeature == "artifacts" {
+		switch msg.String() {
+		case "m":
+			m.markArtifactForDiff()
+			return true, nil
+		case "d":
+			m.diffArtifactWithMarked()
+			return true, nil
+		}
+	}
+
+	// Pager keys apply only when no feature above claimed them.
+	if col, ok := m.focusedColumn(); ok && !m.logsFocused && col == column(m.previewCol) {
+		switch msg.String() {
+		case " ":
+			m.previewCol.PageDown()
+			return true, nil
+		case "b":
+			m.previewCol.PageUp()
+			return true, nil
+		}
+	}
 
 	return false, nil
 }
@@ -2930,6 +3652,7 @@ func (m *model) handleOverlayCloseMouse(msg tea.MouseMsg) bool {
 func (m *model) applyMarkdownTheme(theme markdownTheme, announce bool) {
 	setMarkdownTheme(theme)
 	m.markdownTheme = theme
+	m.applyThemeStyles(theme)
 	if m.previewCol != nil {
 		m.previewCol.Refresh()
 	}
@@ -2941,12 +3664,40 @@ func (m *model) applyMarkdownTheme(theme markdownTheme, announce bool) {
 	}
 }
 
+// applyThemeStyles swaps m.styles for the theme and restyles the help view,
+// spinner and every column. During construction the columns do not exist yet
+// and pick up m.styles as they are built.
+func (m *model) applyThemeStyles(theme markdownTheme) {
+	m.styles = stylesForTheme(theme)
+	m.help.Styles.ShortKey = m.styles.statusHint.Copy()
+	m.help.Styles.ShortDesc = m.styles.statusHint.Copy()
+	m.help.Styles.ShortSeparator = m.styles.statusSeg.Copy()
+	m.help.Styles.Ellipsis = m.styles.statusSeg.Copy()
+	m.help.Styles.FullKey = m.styles.statusHint.Copy()
+	m.help.Styles.FullDesc = m.styles.statusHint.Copy()
+	m.help.Styles.FullSeparator = m.styles.statusSeg.Copy()
+	m.spinner.Style = m.styles.statusHint.Copy().Bold(true)
+	if m.logsCol == nil {
+		return
+	}
+	styled := []interface{ ApplyStyles(styles) }{
+		m.workspaceCol, m.featureCol, m.artifactsCol, m.docTOCCol, m.envTableCol, m.itemsCol,
+		m.servicesCol, m.tokensCol, m.reportsCol, m.telemetryCol, m.backlogCol,
+		m.backlogTable, m.artifactTreeCol, m.previewCol, m.rfpEditorCol, m.logsCol,
+	}
+	for _, col := range styled {
+		col.ApplyStyles(m.styles)
+	}
+}
+
 func (m *model) toggleMarkdownTheme() {
 	m.cycleThemeSetting(1)
 }
 
 func (m *model) stepBack() {
 	defer m.updateVisibleColumns()
+	defer m.syncArtifactWatcher()
+	m.rememberColumnCursors()
 
 	if m.currentFeature == "env" && m.usingEnvLayout {
 		if area, ok := m.focusedArea(); ok {
@@ -2967,6 +3718,13 @@ func (m *model) stepBack() {
 			}
 		}
 	}
+	if m.docTOCVisible && m.currentFeature == "docs" {
+		if col, ok := m.focusedColumn(); ok && col == column(m.docTOCCol) {
+			m.docTOCVisible = false
+			m.setFocusArea(focusItems)
+			return
+		}
+	}
 	if area, ok := m.focusedArea(); ok {
 		switch area {
 		case focusPreview:
@@ -3027,7 +3785,11 @@ func (m *model) handleWorkspaceSelected(item workspaceItem) tea.Cmd {
 			fields["pinned"] = "true"
 		}
 		m.emitTelemetry("workspace_opened", fields)
-		m.previewCol.SetContent(previewPath(&discoveredProject{Path: root.Path}, "."))
+		if dirExists(root.Path) {
+			m.previewCol.SetContent(previewPath(&discoveredProject{Path: root.Path}, "."))
+		} else {
+			m.previewCol.SetContent(fmt.Sprintf("⚠ Workspace root not found:\n%s\n\nRestore the directory or remove the root in Settings.\n", root.Path))
+		}
 		var cmds []tea.Cmd
 		if project := m.projectByPath(cleanPath); project != nil {
 			if cmd := m.handleProjectSelected(project); cmd != nil {
@@ -3060,7 +3822,10 @@ func (m *model) handleProjectSelected(project *discoveredProject) tea.Cmd {
 		return nil
 	}
 	defer m.updateVisibleColumns()
+	defer m.syncArtifactWatcher()
 
+	m.rememberProjectFeature()
+	m.rememberColumnCursors()
 	if m.usingEnvLayout {
 		m.exitEnvEditor()
 	}
@@ -3078,9 +3843,15 @@ func (m *model) handleProjectSelected(project *discoveredProject) tea.Cmd {
 	m.itemsCol.SetItems(nil)
 	m.previewCol.SetContent(previewPath(project, "."))
 	m.setFocusArea(focusFeatures)
+	m.updateCredentialHint()
 	m.appendLog(fmt.Sprintf("Project loaded: %s", project.Name))
 	m.emitTelemetry("project_opened", map[string]string{"path": filepath.Clean(project.Path)})
 	m.envOpenTelemetrySent = false
+	if state, ok := m.projectFeatures[filepath.Clean(project.Path)]; ok {
+		if cmd, restored := m.restoreProjectFeature(state); restored {
+			return cmd
+		}
+	}
 	if prevFeature == "tasks" {
 		if def := findFeatureDefinition("tasks"); def.Key != "" {
 			return m.handleFeatureSelected(def)
@@ -3097,41 +3868,222 @@ func (m *model) handleProjectSelected(project *discoveredProject) tea.Cmd {
 	return nil
 }
 
-func (m *model) populateFeatureList() {
-	if m.featureCol == nil {
+func (m *model) rememberProjectFeature() {
+	if m.currentProject == nil || m.projectFeatures == nil {
 		return
 	}
-	selectedKey := ""
-	removeSelected := false
-	if entry, ok := m.featureCol.SelectedEntry(); ok {
-		switch payload := entry.payload.(type) {
-		case featureDefinition:
-			selectedKey = payload.Key
-		case removeWorkspaceAction:
-			removeSelected = true
+	path := filepath.Clean(m.currentProject.Path)
+	if m.currentFeature == "" {
+		delete(m.projectFeatures, path)
+		return
+	}
+	itemKey := m.currentItem.Key
+	if itemKey == "" && m.itemsCol != nil {
+		if item, ok := m.itemsCol.SelectedItem(); ok {
+			itemKey = item.Key
 		}
 	}
-	items := []list.Item{}
-	if m.isCurrentWorkspaceEmpty() {
-		items = append(items,
-			listEntry{
-				title:   "Add an RFP",
-				desc:    "Copy an external RFP into .gpt-creator/staging/inputs/",
-				payload: emptyWorkspaceAction{key: "add-rfp"},
-			},
-			listEntry{
-				title:   "Create an RFP",
-				desc:    "Generate a starter rfp.md under .gpt-creator/staging/inputs/",
-				payload: emptyWorkspaceAction{key: "create-rfp"},
-			},
-		)
-	} else {
-		items = featureListEntries()
+	m.projectFeatures[path] = projectFeatureState{feature: m.currentFeature, itemKey: itemKey}
+}
+
+func (m *model) restoreProjectFeature(state projectFeatureState) (tea.Cmd, bool) {
+	def := findFeatureDefinition(state.feature)
+	if def.Key == "" || !m.selectFeatureEntry(def.Key) {
+		return nil, false
 	}
-	if m.currentRoot != nil {
-		items = append(items, listEntry{
-			title:   "Remove Workspace…",
-			desc:    "Remove current workspace from list",
+	cmd := m.handleFeatureSelected(def)
+	if state.itemKey == "" || m.itemsCol == nil || !m.inBaseLayout() {
+		return cmd, true
+	}
+	if current, ok := m.itemsCol.SelectedItem(); ok && current.Key == state.itemKey {
+		return cmd, true
+	}
+	m.itemsCol.SelectKey(state.itemKey)
+	item, ok := m.itemsCol.SelectedItem()
+	if !ok || item.Key != state.itemKey {
+		return cmd, true
+	}
+	return tea.Batch(cmd, m.applyItemSelection(m.currentProject, def.Key, item, false)), true
+}
+
+// currentNavLocation describes where the user is for the jump list.
+func (m *model) currentNavLocation() navLocation {
+	loc := navLocation{feature: m.currentFeature, itemKey: m.currentItem.Key, focus: m.focus}
+	if m.currentRoot != nil {
+		loc.root = filepath.Clean(m.currentRoot.Path)
+	}
+	if m.currentProject != nil {
+		loc.project = filepath.Clean(m.currentProject.Path)
+	}
+	if loc.itemKey == "" && loc.feature != "" && m.itemsCol != nil && m.inBaseLayout() {
+		if item, ok := m.itemsCol.SelectedItem(); ok {
+			loc.itemKey = item.Key
+		}
+	}
+	return loc
+}
+
+// recordNavigation runs after every update, so any change of project,
+// feature or focused column becomes a jump-list entry.
+func (m *model) recordNavigation() {
+	if m.currentProject == nil || m.focus < 0 {
+		return
+	}
+	m.navHistory.record(m.currentNavLocation())
+}
+
+// stepNavigation replays the jump-list entry delta steps away. An entry whose
+// project has gone stays in the list; stepping again moves past it.
+func (m *model) stepNavigation(delta int) tea.Cmd {
+	loc, ok := m.navHistory.step(delta)
+	if !ok {
+		m.setToast(ternary(delta < 0, "Already at the oldest location", "Already at the newest location"), 3*time.Second)
+		return nil
+	}
+	defer m.updateVisibleColumns()
+	var cmds []tea.Cmd
+	if m.currentProject == nil || filepath.Clean(m.currentProject.Path) != loc.project {
+		cmds = append(cmds, m.jumpToProject(loc.root, loc.project))
+		if m.currentProject == nil || filepath.Clean(m.currentProject.Path) != loc.project {
+			return tea.Batch(cmds...)
+		}
+	}
+	if loc.feature != "" {
+		current := m.currentNavLocation()
+		if current.feature != loc.feature || current.itemKey != loc.itemKey {
+			cmd, _ := m.restoreProjectFeature(projectFeatureState{feature: loc.feature, itemKey: loc.itemKey})
+			cmds = append(cmds, cmd)
+		}
+	}
+	m.setFocusIndex(loc.focus)
+	m.navHistory.replace(m.currentNavLocation())
+	return tea.Batch(cmds...)
+}
+
+// columnCursorKey scopes a column's remembered row: the feature list per
+// project, every other column per project and feature. The workspace column
+// keeps its own selection and is not tracked.
+func (m *model) columnCursorKey(col column) string {
+	if m.currentProject == nil || col == nil || col == column(m.workspaceCol) {
+		return ""
+	}
+	scope := filepath.Clean(m.currentProject.Path)
+	if col != column(m.featureCol) {
+		if m.currentFeature == "" {
+			return ""
+		}
+		scope += "|" + m.currentFeature
+	}
+	return scope + "|" + col.Title()
+}
+
+// rememberColumnCursors records the selected row of every visible column
+// before the layout changes.
+func (m *model) rememberColumnCursors() {
+	if m.columnCursors == nil {
+		return
+	}
+	for _, col := range m.columns {
+		aware, ok := col.(cursorAwareColumn)
+		if !ok {
+			continue
+		}
+		key := m.columnCursorKey(col)
+		if key == "" {
+			continue
+		}
+		if index := aware.CursorIndex(); index >= 0 {
+			m.columnCursors[key] = index
+		}
+	}
+}
+
+// restoreColumnCursor moves col back to the row remembered for the current
+// context. Positions are restored once, so later reloads of the same view
+// keep whatever the user has selected since.
+func (m *model) restoreColumnCursor(col column) bool {
+	aware, ok := col.(cursorAwareColumn)
+	if !ok {
+		return false
+	}
+	key := m.columnCursorKey(col)
+	index, found := m.columnCursors[key]
+	if key == "" || !found {
+		return false
+	}
+	delete(m.columnCursors, key)
+	aware.SetCursorIndex(index)
+	return aware.CursorIndex() == index
+}
+
+// gotoFeature opens a feature of the current project directly, as if it had
+// been chosen in the feature column.
+func (m *model) gotoFeature(key string) tea.Cmd {
+	if m.currentProject == nil {
+		m.appendLog("Select a project before jumping to a feature.")
+		m.setToast("Select a project first", 4*time.Second)
+		return nil
+	}
+	def := findFeatureDefinition(key)
+	if def.Key == "" {
+		return nil
+	}
+	m.selectFeatureEntry(def.Key)
+	return m.handleFeatureSelected(def)
+}
+
+func (m *model) selectFeatureEntry(key string) bool {
+	if m.featureCol == nil || key == "" {
+		return false
+	}
+	for i, item := range m.featureCol.model.Items() {
+		entry, ok := item.(listEntry)
+		if !ok {
+			continue
+		}
+		if def, ok := entry.payload.(featureDefinition); ok && def.Key == key {
+			m.featureCol.model.Select(i)
+			return true
+		}
+	}
+	return false
+}
+
+func (m *model) populateFeatureList() {
+	if m.featureCol == nil {
+		return
+	}
+	selectedKey := ""
+	removeSelected := false
+	if entry, ok := m.featureCol.SelectedEntry(); ok {
+		switch payload := entry.payload.(type) {
+		case featureDefinition:
+			selectedKey = payload.Key
+		case removeWorkspaceAction:
+			removeSelected = true
+		}
+	}
+	items := []list.Item{}
+	if m.isCurrentWorkspaceEmpty() {
+		items = append(items,
+			listEntry{
+				title:   "Add an RFP",
+				desc:    "Copy an external RFP into .gpt-creator/staging/inputs/",
+				payload: emptyWorkspaceAction{key: "add-rfp"},
+			},
+			listEntry{
+				title:   "Create an RFP",
+				desc:    "Generate a starter rfp.md under .gpt-creator/staging/inputs/",
+				payload: emptyWorkspaceAction{key: "create-rfp"},
+			},
+		)
+	} else {
+		items = featureListEntries(m.hiddenFeatures)
+	}
+	if m.currentRoot != nil {
+		items = append(items, listEntry{
+			title:   "Remove Workspace…",
+			desc:    "Remove current workspace from list",
 			payload: removeWorkspaceAction{},
 		})
 	}
@@ -3141,6 +4093,7 @@ func (m *model) populateFeatureList() {
 		return
 	}
 	if selectedKey == "" {
+		m.restoreColumnCursor(m.featureCol)
 		return
 	}
 	for i, item := range items {
@@ -3239,7 +4192,7 @@ func (m *model) openRfpEditor() tea.Cmd {
 }
 
 func (m *model) saveRfpDraft(content string) tea.Cmd {
-	if m.currentProject == nil {
+	if m.currentProject == nil || m.rejectInReadOnly() {
 		return nil
 	}
 	root := filepath.Clean(m.currentProject.Path)
@@ -3336,7 +4289,9 @@ func (m *model) handleFeatureSelected(feature featureDefinition) tea.Cmd {
 		return nil
 	}
 	defer m.updateVisibleColumns()
+	defer m.syncArtifactWatcher()
 
+	m.rememberColumnCursors()
 	if m.usingRfpEditor {
 		m.useRfpEditorLayout(false)
 	}
@@ -3349,16 +4304,22 @@ func (m *model) handleFeatureSelected(feature featureDefinition) tea.Cmd {
 	if feature.Key != "reports" && m.usingReportsLayout {
 		m.exitReportsView()
 	}
+	if feature.Key != "telemetry" && m.usingTelemetryLayout {
+		m.exitTelemetryView()
+	}
 	m.currentFeature = feature.Key
 	m.currentItem = featureItemDefinition{}
 	m.itemsActivated = false
 	m.resetDocSelection()
+	m.previewCol.SetRaw((feature.Key == "docs" && m.docsRawMarkdown) || feature.Key == "codex-log")
 	if feature.Key != "generate" {
 		m.currentGenerateTarget = ""
 		m.currentGenerateFile = ""
 	}
 	m.currentVerifyCheck = ""
+	m.verifySplit = false
 	m.stopServicePolling()
+	m.stopDashboardRefresh()
 	m.currentServiceEndpoints = nil
 	if feature.Key != "tasks" {
 		m.hideSpinner()
@@ -3369,7 +4330,7 @@ func (m *model) handleFeatureSelected(feature featureDefinition) tea.Cmd {
 	if feature.Key == "tasks" {
 		m.useTasksLayout(true)
 		m.backlogScope = backlogNode{}
-		m.previewCol.SetContent("Loading backlog…\n")
+		m.previewCol.SetContent(m.withFeatureKeyHelp("Loading backlog…\n"))
 		m.updateCredentialHint()
 		m.setFocusArea(focusFeatures)
 		m.backlogLoading = true
@@ -3397,9 +4358,9 @@ func (m *model) handleFeatureSelected(feature featureDefinition) tea.Cmd {
 		m.tokensUsage = nil
 		m.tokensTelemetrySent = false
 		m.tokensCol.SetPlaceholder("Loading token usage…")
-		m.previewCol.SetContent("Loading token usage…\n")
+		m.previewCol.SetContent(m.withFeatureKeyHelp("Loading token usage…\n"))
 		m.setFocusArea(focusItems)
-		return m.loadTokensUsageCmd()
+		return tea.Batch(m.loadTokensUsageCmd(), m.startDashboardRefresh())
 	}
 	if feature.Key == "artifacts" {
 		m.useServicesLayout(false)
@@ -3417,7 +4378,7 @@ func (m *model) handleFeatureSelected(feature featureDefinition) tea.Cmd {
 		if m.currentProject == nil {
 			m.previewCol.SetContent("Select a project to browse artifacts.\n")
 		} else {
-			m.previewCol.SetContent("No artifacts detected. Run `gpt-creator generate all` or `create-project` to populate staging outputs.\n")
+			m.previewCol.SetContent(m.withFeatureKeyHelp("No artifacts detected. Run `gpt-creator generate all` or `create-project` to populate staging outputs.\n"))
 		}
 		m.setFocusArea(focusItems)
 		return cmd
@@ -3426,7 +4387,7 @@ func (m *model) handleFeatureSelected(feature featureDefinition) tea.Cmd {
 		m.useEnvLayout(false)
 		m.useServicesLayout(true)
 		m.servicesCol.SetItems(nil)
-		m.previewCol.SetContent("Gathering docker-compose services…\n")
+		m.previewCol.SetContent(m.withFeatureKeyHelp("Gathering docker-compose services…\n"))
 		cmds := []tea.Cmd{}
 		if cmd := m.loadServicesCmd(); cmd != nil {
 			cmds = append(cmds, cmd)
@@ -3448,9 +4409,19 @@ func (m *model) handleFeatureSelected(feature featureDefinition) tea.Cmd {
 		m.reportEntries = nil
 		m.reportsTelemetrySent = false
 		m.reportsCol.SetPlaceholder("Loading reports…")
-		m.previewCol.SetContent("Loading reports…\n")
+		m.previewCol.SetContent(m.withFeatureKeyHelp("Loading reports…\n"))
 		m.setFocusArea(focusItems)
-		return m.loadReportsEntriesCmd()
+		return tea.Batch(m.loadReportsEntriesCmd(), m.startDashboardRefresh())
+	}
+	if feature.Key == "telemetry" {
+		m.useEnvLayout(false)
+		m.useServicesLayout(false)
+		m.useArtifactsLayout(false)
+		m.useTokensLayout(false)
+		m.useReportsLayout(false)
+		m.useTelemetryLayout(true)
+		m.setFocusArea(focusItems)
+		return m.reloadTelemetryEvents()
 	}
 	if feature.Key == "settings" {
 		m.useEnvLayout(false)
@@ -3474,10 +4445,13 @@ func (m *model) handleFeatureSelected(feature featureDefinition) tea.Cmd {
 		m.itemsCol.SetTitle("Docs")
 	} else if feature.Key == "generate" {
 		m.itemsCol.SetTitle("Targets")
+	} else if feature.Key == "codex-log" {
+		m.itemsCol.SetTitle("Logs")
 	} else {
 		m.itemsCol.SetTitle("Actions")
 	}
 	m.itemsCol.SetItems(featureItemEntries(m.currentProject, feature.Key, m.dockerAvailable))
+	m.restoreColumnCursor(m.itemsCol)
 	var followCmds []tea.Cmd
 	if item, ok := m.itemsCol.SelectedItem(); ok {
 		if feature.Key == "overview" {
@@ -3487,7 +4461,11 @@ func (m *model) handleFeatureSelected(feature featureDefinition) tea.Cmd {
 			followCmds = append(followCmds, cmd)
 		}
 	} else {
-		m.previewCol.SetContent("Select an item to preview details.\n")
+		m.previewCol.SetContent(m.withFeatureKeyHelp("Select an item to preview details.\n"))
+	}
+	if feature.Key == "codex-log" && !m.codexLogTicking {
+		m.codexLogTicking = true
+		followCmds = append(followCmds, codexLogTick())
 	}
 	m.setFocusArea(focusItems)
 	if len(followCmds) > 0 {
@@ -3566,6 +4544,8 @@ func (m *model) applyItemSelection(project *discoveredProject, featureKey string
 	m.currentItem = item
 	m.currentFeature = featureKey
 	m.currentProject = project
+	m.diagnosticsActive = false
+	m.dockerCheckActive = false
 	var followCmds []tea.Cmd
 	if featureKey == "docs" {
 		if cmd := m.handleDocItemSelection(item, activate); cmd != nil {
@@ -3581,6 +4561,9 @@ func (m *model) applyItemSelection(project *discoveredProject, featureKey string
 	if featureKey == "database" {
 		m.handleDatabaseItemSelection(item)
 	}
+	if featureKey == "notes" && activate {
+		m.openNotesEditor()
+	}
 	if featureKey == "services" {
 		m.handleServiceItemSelection(item)
 	} else {
@@ -3590,7 +4573,21 @@ func (m *model) applyItemSelection(project *discoveredProject, featureKey string
 	if extra := renderDetailedPreview(project, featureKey, item); extra != "" {
 		content += "\n\n" + extra
 	}
-	m.previewCol.SetContent(content)
+	m.previewCol.SetRaw((featureKey == "docs" && m.docsRawMarkdown) || featureKey == "codex-log")
+	if featureKey == "verify" && m.verifySplit {
+		if split, ok := renderVerifySplitPreview(project, item); ok {
+			content = split
+		}
+	}
+	if featureKey == "notes" {
+		m.previewCol.SetMarkdownContent(renderNotesPreview(project))
+	} else {
+		m.previewCol.SetContent(content)
+	}
+	if featureKey == "codex-log" {
+		m.codexLogStamp = codexLogStamp(project, item)
+		m.previewCol.GotoBottom()
+	}
 	if featureKey == "overview" && !activate {
 		if m.suppressPipelineTelemetry {
 			m.suppressPipelineTelemetry = false
@@ -3639,6 +4636,7 @@ func (m *model) prepareArtifactsView() (tea.Cmd, bool) {
 		})
 	}
 	m.artifactsCol.SetItems(items)
+	m.restoreColumnCursor(m.artifactsCol)
 	m.artifactTreeCol.SetNodes(nil)
 	m.currentArtifactCategory = ""
 	m.currentArtifactKey = ""
@@ -3756,6 +4754,7 @@ func (m *model) handleArtifactNodeHighlighted(node artifactNode) {
 	}
 	m.currentArtifactKey = node.Key
 	m.currentArtifactRel = node.Rel
+	m.syncArtifactWatcher()
 	if node.IsDir {
 		m.clearArtifactSplit()
 		m.previewCol.SetContent(m.renderArtifactPreview(node))
@@ -3808,8 +4807,7 @@ func (m *model) handleArtifactNodeActivated(node artifactNode) tea.Cmd {
 	}
 	m.currentArtifactKey = node.Key
 	m.currentArtifactRel = node.Rel
-	m.openCurrentArtifactInEditor()
-	return nil
+	return m.openCurrentArtifactInEditor()
 }
 
 func (m *model) renderArtifactPreview(node artifactNode) string {
@@ -3820,7 +4818,19 @@ func (m *model) renderArtifactPreview(node artifactNode) string {
 	if rel == "" {
 		rel = "."
 	}
-	snippet := previewPath(m.currentProject, filepath.FromSlash(rel))
+	snippet := ""
+	if !node.IsDir {
+		snippet = m.inlineImagePreview(m.artifactAbsolutePath(rel))
+	}
+	if snippet == "" && !node.IsDir && m.previewLineNumbers {
+		abs := m.artifactAbsolutePath(rel)
+		if content := readFileSnippet(abs); content != "" {
+			snippet = fmt.Sprintf("%s\n\n%s", abs, numberLines(content))
+		}
+	}
+	if snippet == "" {
+		snippet = previewPath(m.currentProject, filepath.FromSlash(rel))
+	}
 	if strings.TrimSpace(snippet) == "" {
 		header := m.artifactAbsolutePath(rel)
 		if node.IsDir {
@@ -3833,6 +4843,11 @@ func (m *model) renderArtifactPreview(node artifactNode) string {
 	actions := []string{"o open in editor", "y copy path"}
 	if !node.IsDir {
 		actions = append(actions, "Y copy snippet", "s split diff")
+		if m.artifactDiffMark == "" {
+			actions = append(actions, "m mark for diff")
+		} else {
+			actions = append(actions, "d diff with "+m.artifactDiffLabel(m.artifactDiffMark))
+		}
 	}
 	return fmt.Sprintf("%s\n\nActions: %s\n", snippet, strings.Join(actions, " • "))
 }
@@ -3872,7 +4887,7 @@ func (m *model) renderArtifactSplitPreview(planRel, targetRel string) string {
 	rightContent := readFileLimited(rightPath, maxDocPreviewBytes, maxDiffPreviewLines)
 	leftLines := strings.Split(leftContent, "\n")
 	rightLines := strings.Split(rightContent, "\n")
-	view := renderSideBySideDiff(planRel, targetRel, leftLines, rightLines)
+	view := renderSideBySideDiff(planRel, targetRel, leftLines, rightLines, m.previewLineNumbers)
 	if strings.TrimSpace(view) == "" {
 		return fmt.Sprintf("No diff available between %s and %s.\n", planRel, targetRel)
 	}
@@ -3881,7 +4896,9 @@ func (m *model) renderArtifactSplitPreview(planRel, targetRel string) string {
 
 const artifactSplitColumnWidth = 48
 
-func renderSideBySideDiff(leftLabel, rightLabel string, leftLines, rightLines []string) string {
+// renderSideBySideDiff pairs the diff of two files in parallel panes. With
+// numbered set each side carries its own original line numbers.
+func renderSideBySideDiff(leftLabel, rightLabel string, leftLines, rightLines []string, numbered bool) string {
 	width := artifactSplitColumnWidth
 	var builder strings.Builder
 	header := fmt.Sprintf("%-*s │ %-*s\n", width, leftLabel, width, rightLabel)
@@ -3889,13 +4906,21 @@ func renderSideBySideDiff(leftLabel, rightLabel string, leftLines, rightLines []
 	builder.WriteString(header)
 	builder.WriteString(divider)
 
+	gutter := func(n int) string { return "" }
+	if numbered {
+		digits := len(strconv.Itoa(max(len(leftLines), len(rightLines))))
+		gutter = func(n int) string { return fmt.Sprintf("%*d ", digits, n) }
+	}
+	leftNo, rightNo := 0, 0
 	lines := 0
 	chunks := diffLines(leftLines, rightLines)
 	for _, chunk := range chunks {
 		switch chunk.op {
 		case diffEqual:
 			for _, line := range chunk.lines {
-				builder.WriteString(formatSplitRow("  "+line, "  "+line, width))
+				leftNo++
+				rightNo++
+				builder.WriteString(formatSplitRow(gutter(leftNo)+"  "+line, gutter(rightNo)+"  "+line, width))
 				lines++
 				if lines >= maxDiffPreviewLines {
 					builder.WriteString("… truncated\n")
@@ -3904,7 +4929,8 @@ func renderSideBySideDiff(leftLabel, rightLabel string, leftLines, rightLines []
 			}
 		case diffDelete:
 			for _, line := range chunk.lines {
-				builder.WriteString(formatSplitRow("- "+line, "", width))
+				leftNo++
+				builder.WriteString(formatSplitRow(gutter(leftNo)+"- "+line, "", width))
 				lines++
 				if lines >= maxDiffPreviewLines {
 					builder.WriteString("… truncated\n")
@@ -3913,7 +4939,8 @@ func renderSideBySideDiff(leftLabel, rightLabel string, leftLines, rightLines []
 			}
 		case diffInsert:
 			for _, line := range chunk.lines {
-				builder.WriteString(formatSplitRow("", "+ "+line, width))
+				rightNo++
+				builder.WriteString(formatSplitRow("", gutter(rightNo)+"+ "+line, width))
 				lines++
 				if lines >= maxDiffPreviewLines {
 					builder.WriteString("… truncated\n")
@@ -3925,6 +4952,31 @@ func renderSideBySideDiff(leftLabel, rightLabel string, leftLines, rightLines []
 	return strings.TrimRight(builder.String(), "\n")
 }
 
+// renderSideBySideFiles lays out two files in parallel panes, pairing lines by
+// position rather than by diff.
+func renderSideBySideFiles(leftLabel, rightLabel string, leftLines, rightLines []string) string {
+	width := artifactSplitColumnWidth
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%-*s │ %-*s\n", width, leftLabel, width, rightLabel))
+	builder.WriteString(strings.Repeat("─", width) + "─┼─" + strings.Repeat("─", width) + "\n")
+	rows := max(len(leftLines), len(rightLines))
+	for i := 0; i < rows; i++ {
+		if i >= maxDiffPreviewLines {
+			builder.WriteString("… truncated\n")
+			break
+		}
+		left, right := "", ""
+		if i < len(leftLines) {
+			left = leftLines[i]
+		}
+		if i < len(rightLines) {
+			right = rightLines[i]
+		}
+		builder.WriteString(formatSplitRow(left, right, width))
+	}
+	return strings.TrimRight(builder.String(), "\n")
+}
+
 func formatSplitRow(left, right string, width int) string {
 	return fmt.Sprintf("%s │ %s\n", padOrTrim(left, width), padOrTrim(right, width))
 }
@@ -4003,36 +5055,125 @@ func (m *model) toggleArtifactSplit() {
 	m.setToast("Split diff disabled", 3*time.Second)
 }
 
-func (m *model) openCurrentArtifactInEditor() {
+// markArtifactForDiff remembers the selected file as the left side of a diff;
+// marking the same file again clears the mark.
+func (m *model) markArtifactForDiff() {
+	node := m.currentArtifactNode()
+	if node == nil || node.IsDir {
+		m.setToast("Select a file to mark", 4*time.Second)
+		return
+	}
+	abs := filepath.Clean(m.artifactAbsolutePath(node.Rel))
+	if m.artifactDiffMark == abs {
+		m.artifactDiffMark = ""
+		m.setToast("Diff mark cleared", 3*time.Second)
+	} else {
+		m.artifactDiffMark = abs
+		m.setToast(fmt.Sprintf("Marked %s — select another file and press d", node.Rel), 5*time.Second)
+	}
+	m.previewCol.SetContent(m.renderArtifactPreview(*node))
+}
+
+// diffArtifactWithMarked shows the marked file beside the selected one and
+// clears the mark.
+func (m *model) diffArtifactWithMarked() {
+	if m.artifactDiffMark == "" {
+		m.setToast("Mark a file with m first", 4*time.Second)
+		return
+	}
+	node := m.currentArtifactNode()
+	if node == nil || node.IsDir {
+		m.setToast("Select a file to compare", 4*time.Second)
+		return
+	}
+	leftPath := m.artifactDiffMark
+	rightPath := filepath.Clean(m.artifactAbsolutePath(node.Rel))
+	if leftPath == rightPath {
+		m.setToast("Select a different file to compare", 4*time.Second)
+		return
+	}
+	if !fileExists(leftPath) {
+		m.artifactDiffMark = ""
+		m.setToast("Marked file no longer exists", 4*time.Second)
+		return
+	}
+	leftLabel := m.artifactDiffLabel(leftPath)
+	rightLabel := m.artifactDiffLabel(rightPath)
+	leftLines := strings.Split(readFileLimited(leftPath, maxDocPreviewBytes, maxDiffPreviewLines), "\n")
+	rightLines := strings.Split(readFileLimited(rightPath, maxDocPreviewBytes, maxDiffPreviewLines), "\n")
+	view := renderSideBySideDiff(leftLabel, rightLabel, leftLines, rightLines, m.previewLineNumbers)
+	if strings.TrimSpace(view) == "" {
+		view = fmt.Sprintf("No diff available between %s and %s.", leftLabel, rightLabel)
+	}
+	m.artifactDiffMark = ""
+	m.clearArtifactSplit()
+	m.previewCol.SetContent(view + "\n\nSelect another file to leave the diff.\n")
+}
+
+// artifactDiffLabel shows path relative to the project when it lies inside it.
+func (m *model) artifactDiffLabel(path string) string {
+	if m.currentProject != nil {
+		if rel, err := filepath.Rel(m.currentProject.Path, path); err == nil && !strings.HasPrefix(rel, "..") {
+			return filepath.ToSlash(rel)
+		}
+	}
+	return abbreviatePath(path)
+}
+
+func (m *model) toggleVerifySplit() {
+	item := m.currentItem
+	if item.Meta == nil || (strings.TrimSpace(item.Meta["verifyLog"]) == "" && strings.TrimSpace(item.Meta["verifyReport"]) == "") {
+		m.setToast("Select a verify check with a report or log", 4*time.Second)
+		return
+	}
+	m.verifySplit = !m.verifySplit
+	if m.currentProject != nil {
+		content := itemPreview(m.currentProject, "verify", item)
+		if extra := renderDetailedPreview(m.currentProject, "verify", item); extra != "" {
+			content += "\n\n" + extra
+		}
+		if m.verifySplit {
+			if split, ok := renderVerifySplitPreview(m.currentProject, item); ok {
+				content = split
+			}
+		}
+		m.previewCol.SetContent(content)
+	}
+	m.setToast(ternary(m.verifySplit, "Report/log split enabled", "Report/log split disabled"), 3*time.Second)
+}
+
+func (m *model) openCurrentArtifactInEditor() tea.Cmd {
 	if m.currentProject == nil {
 		m.appendLog("Select a project before opening files.")
-		return
+		return nil
 	}
 	node := m.currentArtifactNode()
 	if node == nil || node.IsDir {
 		m.appendLog("Select a file to open in the editor.")
 		m.setToast("Select a file first", 4*time.Second)
-		return
+		return nil
 	}
 	abs := m.artifactAbsolutePath(node.Rel)
 	if _, err := os.Stat(abs); err != nil {
 		m.appendLog(fmt.Sprintf("Artifact not found: %s", abs))
 		m.setToast("File not found", 5*time.Second)
-		return
+		return nil
 	}
-	commandLine, err := launchEditor(abs)
+	commandLine, editorCmd, err := launchEditor(abs)
 	if err != nil {
 		m.appendLog(fmt.Sprintf("Failed to open artifact: %v", err))
 		m.setToast("Failed to open file", 5*time.Second)
-		return
+		return nil
 	}
 	m.appendLog("Opening artifact: " + commandLine)
 	m.setToast("Opening artifact in editor", 4*time.Second)
+	m.recordRecentFile(abs)
 	fields := map[string]string{
 		"path": filepath.Clean(m.currentProject.Path),
 		"file": node.Rel,
 	}
 	m.emitTelemetry("artifact_opened", fields)
+	return editorCmd
 }
 
 func (m *model) copyCurrentArtifactPath() {
@@ -4115,6 +5256,9 @@ func (m *model) handleJobMessage(msg jobMsg) tea.Cmd {
 			}
 		}
 		m.appendLog(message.Line)
+		if status, ok := m.jobStatuses[message.ID]; ok && len(status.Output) < maxJobOutputLines {
+			status.Output = append(status.Output, message.Line)
+		}
 		m.refreshCreateProjectProgress(message.Title)
 
 	case jobCancelledMsg:
@@ -4173,17 +5317,21 @@ func (m *model) handleJobMessage(msg jobMsg) tea.Cmd {
 				fields["status"] = "failed"
 				fields["error"] = errText
 				m.appendLog(fmt.Sprintf("[job] %s failed: %v", message.Title, message.Err))
+				m.lastFailureLogIndex = len(m.logLines) - 1
+				m.lastFailurePending = true
 				if elapsed > 0 {
-					m.setToast(fmt.Sprintf("%s failed after %s", message.Title, formatElapsed(elapsed)), 6*time.Second)
+					m.setToast(fmt.Sprintf("%s failed after %s • ctrl+l to view", message.Title, formatElapsed(elapsed)), 6*time.Second)
 				} else {
-					m.setToast(fmt.Sprintf("%s failed", message.Title), 6*time.Second)
+					m.setToast(fmt.Sprintf("%s failed • ctrl+l to view", message.Title), 6*time.Second)
 				}
 				m.emitTelemetry("job_failed", fields)
 			}
 		} else {
 			status.Status = "Succeeded"
 			status.Err = ""
+			m.lastFailurePending = false
 			fields["status"] = "succeeded"
+			m.recordJobDuration(status.Title, duration)
 			m.appendLog(fmt.Sprintf("[job] %s completed successfully", message.Title))
 			if elapsed > 0 {
 				m.setToast(fmt.Sprintf("%s completed in %s", message.Title, formatElapsed(elapsed)), 6*time.Second)
@@ -4279,6 +5427,12 @@ func (m *model) handleJobMessage(msg jobMsg) tea.Cmd {
 	if runnerCmd != nil {
 		cmds = append(cmds, runnerCmd)
 	}
+	if path := m.autoVerifyPending; path != "" {
+		m.autoVerifyPending = ""
+		if cmd := m.runAutoVerify(path); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
 
 	switch reason {
 	case "create-jira-tasks", "migrate-tasks", "refine-tasks", "create-tasks", "work-on-tasks":
@@ -4315,6 +5469,30 @@ func (m *model) beginJobTiming(title string) tea.Cmd {
 	return tea.Batch(m.jobStopwatch.Reset(), m.jobStopwatch.Start())
 }
 
+// recordJobDuration keeps a successful run's duration for later ETAs.
+func (m *model) recordJobDuration(title string, duration time.Duration) {
+	if m.jobDurations == nil {
+		m.jobDurations = jobDurationHistory{}
+	}
+	m.jobDurations.record(title, duration)
+	if err := saveJobDurationHistory(m.jobDurations); err != nil {
+		m.appendDebugLog("job history save failed: %v", err)
+	}
+}
+
+// jobETALabel estimates the time left for title from its past runs, or
+// returns "" when there are too few of them.
+func (m *model) jobETALabel(title string, elapsed time.Duration) string {
+	average, ok := m.jobDurations.estimate(title)
+	if !ok {
+		return ""
+	}
+	if remaining := average - elapsed; remaining > 0 {
+		return "~" + formatElapsed(remaining) + " left"
+	}
+	return "usually ~" + formatElapsed(average)
+}
+
 func (m *model) stopJobTiming() tea.Cmd {
 	if !m.jobTimingActive {
 		return nil
@@ -4427,6 +5605,7 @@ func (m *model) updateProjectStats(path string) {
 		if m.currentProject != nil && filepath.Clean(m.currentProject.Path) == clean {
 			m.currentProject.Stats = stats
 		}
+		m.annotateWorkspaceGitState()
 		return
 	}
 }
@@ -4476,7 +5655,7 @@ func (m *model) refreshVerifySummary(path string) tea.Cmd {
 }
 
 func (m *model) handleInputSubmit(value string) (tea.Cmd, bool) {
-	allowEmpty := m.inputMode == inputEnvEditValue || m.inputMode == inputEnvNewValue
+	allowEmpty := m.inputMode == inputEnvEditValue || m.inputMode == inputEnvNewValue || m.inputMode == inputProjectSearch || m.inputMode == inputTelemetryFilter || m.inputMode == inputSettingsConfirmCommands || m.inputMode == inputPreviewFind || m.inputMode == inputLogsFind || m.inputMode == inputNewProjectTemplate || m.inputMode == inputJobEnv || m.inputMode == inputRecentFiles || m.inputMode == inputSettingsFeatures || m.inputMode == inputNotes
 	if value == "" && !allowEmpty {
 		return nil, false
 	}
@@ -4499,12 +5678,8 @@ func (m *model) handleInputSubmit(value string) (tea.Cmd, bool) {
 		return m.handleNewProjectPathSubmit(value)
 	case inputNewProjectConfirm:
 		if strings.EqualFold(strings.TrimSpace(value), "yes") {
-			cmd, keep := m.finalizeNewProject(m.pendingNewProjectPath)
-			if !keep {
-				m.pendingNewProjectPath = ""
-				m.pendingNewProjectTemplate = ""
-			}
-			return cmd, keep
+			m.openTemplatePrompt()
+			return nil, true
 		} else {
 			m.appendLog("Create project cancelled.")
 			m.setToast("Create project cancelled", 4*time.Second)
@@ -4513,20 +5688,37 @@ func (m *model) handleInputSubmit(value string) (tea.Cmd, bool) {
 		}
 		return nil, false
 	case inputNewProjectTemplate:
-		cmd, keep := m.finalizeNewProject(m.pendingNewProjectPath)
-		if !keep {
-			m.pendingNewProjectPath = ""
-			m.pendingNewProjectTemplate = ""
+		path := m.pendingNewProjectPath
+		m.pendingNewProjectTemplate = m.selectedTemplate(value)
+		cmd, keep := m.finalizeNewProject(path)
+		if keep {
+			return cmd, keep
 		}
-		return cmd, keep
+		launch := m.launchCreateProject(path, m.pendingNewProjectTemplate)
+		m.pendingNewProjectPath = ""
+		m.pendingNewProjectTemplate = ""
+		// Keep the overlay when create-project asked for confirmation.
+		return tea.Batch(cmd, launch), m.inputMode == inputCommandConfirm
 	case inputAttachRFP:
 		keep := m.handleAttachRFPSubmit(value)
 		return nil, keep
 	case inputCommandPalette:
-		return m.executePaletteCommand(value), false
+		cmd := m.executePaletteCommand(value)
+		// Keep the overlay when the entry opened a follow-up prompt.
+		return cmd, m.inputMode != inputCommandPalette
+	case inputProjectSearch:
+		return m.executeProjectSearch(), false
+	case inputRecentFiles:
+		return m.openSelectedRecentFile(), false
+	case inputSettingsFeatures:
+		m.toggleSelectedFeatureVisibility()
+		return nil, true
 	case inputEnvEditValue:
 		m.applyEnvValueEdit(value)
 		return nil, false
+	case inputNotes:
+		// closeInput saves the notes.
+		return nil, false
 	case inputEnvNewKey:
 		key := strings.TrimSpace(value)
 		if key == "" {
@@ -4599,39 +5791,163 @@ func (m *model) handleInputSubmit(value string) (tea.Cmd, bool) {
 		}
 		cmd := m.setConcurrency(n)
 		return cmd, false
+	case inputSettingsServicesPoll:
+		trimmed := strings.TrimSpace(value)
+		n, err := strconv.Atoi(trimmed)
+		if err != nil || n < 0 {
+			m.setToast("Enter seconds (0 disables polling)", 4*time.Second)
+			return nil, true
+		}
+		if n > maxServicesPollSeconds {
+			n = maxServicesPollSeconds
+		}
+		cmd := m.setServicesPoll(n)
+		return cmd, false
+	case inputSettingsDashboardRefresh:
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || n < 0 {
+			m.setToast("Enter seconds (0 disables auto-refresh)", 4*time.Second)
+			return nil, true
+		}
+		m.setDashboardRefresh(n)
+		return nil, false
+	case inputSettingsLogLines:
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || n < 1 {
+			m.setToast(fmt.Sprintf("Enter a number of lines (%d–%d)", minLogLines, maxLogLines), 4*time.Second)
+			return nil, true
+		}
+		m.setLogLines(n)
+		return nil, false
+	case inputSettingsExport:
+		return nil, !m.exportSettings(value)
+	case inputSettingsImport:
+		cmd, ok := m.importSettings(value)
+		return cmd, !ok
+	case inputTelemetryFilter:
+		m.telemetryFilter = strings.TrimSpace(value)
+		m.applyTelemetryFilter()
+		return nil, false
+	case inputCommandConfirm:
+		run := m.pendingConfirmRun
+		title := m.pendingConfirmTitle
+		m.pendingConfirmRun = nil
+		m.pendingConfirmTitle = ""
+		if !strings.EqualFold(strings.TrimSpace(value), "yes") || run == nil {
+			m.appendLog(fmt.Sprintf("Cancelled %s", title))
+			m.setToast("Command cancelled", 4*time.Second)
+			return nil, false
+		}
+		return run(), false
+	case inputSettingsConfirmCommands:
+		m.setConfirmCommands(parseConfirmCommands(value))
+		return nil, false
+	case inputDocDiffBase:
+		m.docDiffRevision = strings.TrimSpace(value)
+		m.showDocRevisionDiff(m.docDiffRevision)
+		return nil, false
+	case inputPreviewFind:
+		m.applyPreviewFind(value)
+		return nil, false
+	case inputLogsFind:
+		m.applyLogsFind(value)
+		return nil, false
+	case inputJobEnv:
+		return nil, !m.setNextJobEnv(value)
 	}
 	return nil, false
 }
 
-func (m *model) refreshWorkspaceColumn() {
-	if m.workspaceCol == nil {
-		return
+// handlePreviewFindKey drives in-pane find while the preview is focused: "/"
+// prompts for a query, n/N cycle matches and esc clears the search.
+func (m *model) handlePreviewFindKey(msg tea.KeyMsg) (bool, tea.Cmd) {
+	if m.previewCol == nil {
+		return false, nil
 	}
-	m.ensurePinnedRoots()
-	var items []list.Item
-	if len(m.pinnedPaths) > 0 {
-		items = append(items, listEntry{title: "Pinned", desc: "", payload: nil})
-		sortedPinned := sortedPaths(m.pinnedPaths)
-		for _, path := range sortedPinned {
-			label := labelForPath(path)
-			desc := abbreviatePath(path)
-			items = append(items, listEntry{
-				title:   "★ " + label,
-				desc:    desc,
-				payload: workspaceItem{kind: workspaceKindRoot, path: path, pinned: true},
-			})
-		}
+	if area, ok := m.focusedArea(); !ok || area != focusPreview {
+		return false, nil
+	}
+	switch msg.String() {
+	case "/":
+		m.openInput("Find in preview", m.previewCol.FindQuery(), inputPreviewFind)
+		return true, nil
+	case "n", "N":
+		if !m.previewCol.FindActive() {
+			return false, nil
+		}
+		if !m.previewCol.FindNext(ternary(msg.String() == "n", 1, -1)) {
+			m.setToast(fmt.Sprintf("No matches for %q", m.previewCol.FindQuery()), 3*time.Second)
+		}
+		return true, nil
+	case "esc":
+		if !m.previewCol.FindActive() {
+			return false, nil
+		}
+		m.previewCol.ClearFind()
+		return true, nil
+	}
+	return false, nil
+}
+
+func (m *model) applyPreviewFind(query string) {
+	if m.previewCol == nil {
+		return
+	}
+	query = strings.TrimSpace(query)
+	if query == "" {
+		m.previewCol.ClearFind()
+		return
+	}
+	if m.previewCol.SetFind(query) {
+		m.setToast(fmt.Sprintf("%d matches for %q", m.previewCol.FindCount(), query), 3*time.Second)
+	} else {
+		m.setToast(fmt.Sprintf("No matches for %q", query), 3*time.Second)
+	}
+	fields := map[string]string{"feature": m.currentFeature}
+	if m.currentProject != nil {
+		fields["path"] = filepath.Clean(m.currentProject.Path)
+	}
+	m.emitTelemetry("preview_find", fields)
+}
+
+func (m *model) refreshWorkspaceColumn() {
+	if m.workspaceCol == nil {
+		return
+	}
+	m.ensurePinnedRoots()
+	var items []list.Item
+	if len(m.pinnedPaths) > 0 {
+		items = append(items, listEntry{title: "Pinned", desc: "", payload: nil})
+		sortedPinned := sortedPaths(m.pinnedPaths)
+		for _, path := range sortedPinned {
+			title := "★ " + labelForPath(path)
+			missing := !dirExists(path)
+			if missing {
+				title = "★ ⚠ " + labelForPath(path)
+			}
+			items = append(items, listEntry{
+				title:   title,
+				desc:    abbreviatePath(path),
+				payload: workspaceItem{kind: workspaceKindRoot, path: path, pinned: true},
+				dimmed:  missing,
+			})
+		}
 	}
 	for _, root := range m.workspaceRoots {
 		clean := filepath.Clean(root.Path)
 		if m.pinnedPaths[clean] {
 			continue
 		}
-		desc := abbreviatePath(root.Path)
+		title := root.Label
+		missing := !dirExists(root.Path)
+		if missing {
+			title = "⚠ " + root.Label
+		}
 		items = append(items, listEntry{
-			title:   root.Label,
-			desc:    desc,
+			title:   title,
+			desc:    abbreviatePath(root.Path),
 			payload: workspaceItem{kind: workspaceKindRoot, path: root.Path, pinned: false},
+			dimmed:  missing,
 		})
 	}
 	items = append(items, listEntry{
@@ -4647,10 +5963,38 @@ func (m *model) refreshWorkspaceColumn() {
 		payload: workspaceItem{kind: workspaceKindAddRoot},
 	})
 	m.workspaceCol.SetItems(items)
+	m.annotateWorkspaceGitState()
+}
+
+// annotateWorkspaceGitState appends the cached git branch of discovered
+// projects to their workspace entries, keeping the current selection.
+func (m *model) annotateWorkspaceGitState() {
+	if m.workspaceCol == nil {
+		return
+	}
+	for i, listItem := range m.workspaceCol.model.Items() {
+		entry, ok := listItem.(listEntry)
+		if !ok {
+			continue
+		}
+		ws, ok := entry.payload.(workspaceItem)
+		if !ok || ws.kind != workspaceKindRoot {
+			continue
+		}
+		desc := abbreviatePath(ws.path)
+		if project := m.projectByPath(ws.path); project != nil && project.Stats.GitBranch != "" {
+			desc += " · " + formatGitState(project.Stats)
+		}
+		if desc != entry.desc {
+			entry.desc = desc
+			m.workspaceCol.model.SetItem(i, entry)
+		}
+	}
 }
 
 func (m *model) refreshProjectsForCurrentRoot() {
 	defer m.updateVisibleColumns()
+	m.syncRootWatcher()
 
 	if m.currentRoot == nil {
 		m.projects = nil
@@ -4664,8 +6008,10 @@ func (m *model) refreshProjectsForCurrentRoot() {
 		return
 	}
 
-	projects, err := discoverProjects(m.currentRoot.Path)
-	if err != nil {
+	if !dirExists(m.currentRoot.Path) {
+		m.appendLog(fmt.Sprintf("Workspace root %s no longer exists; skipping project discovery.", abbreviatePath(m.currentRoot.Path)))
+		m.projects = nil
+	} else if projects, err := discoverProjects(m.currentRoot.Path); err != nil {
 		m.appendLog(fmt.Sprintf("Failed to discover projects: %v", err))
 		m.projects = nil
 	} else {
@@ -4684,6 +6030,7 @@ func (m *model) refreshProjectsForCurrentRoot() {
 			m.recordPipelineTelemetry(proj.Path, proj.Stats)
 		}
 	}
+	m.annotateWorkspaceGitState()
 
 	if m.currentProject == nil {
 		m.featureCol.SetItems(nil)
@@ -4695,6 +6042,112 @@ func (m *model) refreshProjectsForCurrentRoot() {
 	}
 }
 
+// syncRootWatcher starts, stops or retargets the workspace watcher so it
+// follows the current root while auto watch is enabled.
+func (m *model) syncRootWatcher() {
+	want := ""
+	if m.settingsAutoWatch && m.currentRoot != nil {
+		want = filepath.Clean(m.currentRoot.Path)
+	}
+	if m.rootWatcher != nil && m.rootWatcher.root == want {
+		return
+	}
+	if m.rootWatcher != nil {
+		m.rootWatcher.Stop()
+		m.rootWatcher = nil
+	}
+	if want == "" {
+		return
+	}
+	watcher, err := startRootWatcher(want, m.rootChanges)
+	if err != nil {
+		m.appendLog(fmt.Sprintf("Failed to watch %s: %v", abbreviatePath(want), err))
+		return
+	}
+	m.rootWatcher = watcher
+}
+
+// handleCodexLogTick re-renders the selected transcript when the file has
+// grown, following the end of the log unless the user scrolled away.
+func (m *model) handleCodexLogTick() tea.Cmd {
+	if m.currentFeature != "codex-log" || m.currentProject == nil {
+		m.codexLogTicking = false
+		return nil
+	}
+	stamp := codexLogStamp(m.currentProject, m.currentItem)
+	if stamp != "" && stamp != m.codexLogStamp {
+		m.codexLogStamp = stamp
+		follow := m.previewCol.AtBottom()
+		m.previewCol.SetContent(itemPreview(m.currentProject, m.currentFeature, m.currentItem))
+		if follow {
+			m.previewCol.GotoBottom()
+		}
+	}
+	return codexLogTick()
+}
+
+// syncArtifactWatcher follows the previewed artifact file while auto watch is
+// enabled, and stops watching once a directory or another feature is shown.
+func (m *model) syncArtifactWatcher() {
+	want := ""
+	if m.settingsAutoWatch && m.currentFeature == "artifacts" && m.currentProject != nil {
+		if node := m.currentArtifactNode(); node != nil && !node.IsDir {
+			want = filepath.Clean(m.artifactAbsolutePath(node.Rel))
+		}
+	}
+	if m.artifactWatcher != nil && m.artifactWatcher.path == want {
+		return
+	}
+	if m.artifactWatcher != nil {
+		m.artifactWatcher.Stop()
+		m.artifactWatcher = nil
+	}
+	if want == "" {
+		return
+	}
+	watcher, err := startFileWatcher(want, m.artifactChanges)
+	if err != nil {
+		m.appendLog(fmt.Sprintf("Failed to watch %s: %v", abbreviatePath(want), err))
+		return
+	}
+	m.artifactWatcher = watcher
+}
+
+// handleArtifactFileChanged re-renders the artifact preview after the watched
+// file is rewritten, keeping the scroll position unless it was at the end.
+func (m *model) handleArtifactFileChanged(msg fileChangedMsg) {
+	if m.artifactWatcher == nil || m.artifactWatcher.path != msg.path || m.currentFeature != "artifacts" {
+		return
+	}
+	node := m.currentArtifactNode()
+	if node == nil || node.IsDir || filepath.Clean(m.artifactAbsolutePath(node.Rel)) != msg.path {
+		return
+	}
+	follow := m.previewCol.AtBottom()
+	if m.artifactSplit.Enabled {
+		if content, ok := m.refreshArtifactSplit(*node); ok {
+			m.previewCol.SetContent(content)
+		} else {
+			m.clearArtifactSplit()
+			m.previewCol.SetContent(m.renderArtifactPreview(*node))
+		}
+	} else {
+		m.previewCol.SetContent(m.renderArtifactPreview(*node))
+	}
+	if follow {
+		m.previewCol.GotoBottom()
+	}
+}
+
+func (m *model) handleRootChanged(msg rootChangedMsg) {
+	if m.rootWatcher == nil || m.currentRoot == nil || m.rootWatcher.root != msg.root {
+		return
+	}
+	m.refreshProjectsForCurrentRoot()
+	m.refreshWorkspaceColumn()
+	m.appendLog("Workspace changed on disk; rescanned " + abbreviatePath(msg.root))
+}
+
 func (m *model) openInput(prompt, placeholder string, mode inputMode) {
 	m.helpActive = false
 	m.inputMode = mode
@@ -4808,7 +6261,24 @@ func (m *model) closeInput() {
 	prevMode := m.inputMode
 	m.filePickerEnabled = false
 	m.textAreaEnabled = false
-	if prevMode == inputCommandPalette {
+	if prevMode == inputProjectSearch {
+		m.projectSearchEntries = nil
+		m.projectSearchLoading = false
+	}
+	if prevMode == inputNewProjectTemplate {
+		m.templateEntries = nil
+	}
+	if prevMode == inputRecentFiles {
+		m.recentFileEntries = nil
+	}
+	if prevMode == inputSettingsFeatures {
+		m.featureVisibilityEntries = nil
+	}
+	if prevMode == inputNotes {
+		// Notes autosave however the overlay is closed.
+		m.saveProjectNotes(m.inputArea.Value())
+	}
+	if prevMode == inputCommandPalette || prevMode == inputProjectSearch || prevMode == inputNewProjectTemplate || prevMode == inputRecentFiles || prevMode == inputSettingsFeatures {
 		m.paletteMatches = nil
 		m.paletteIndex = 0
 		m.palettePaginator.Page = 0
@@ -4825,6 +6295,10 @@ func (m *model) closeInput() {
 		m.pendingNewProjectPath = ""
 		m.pendingNewProjectTemplate = ""
 	}
+	if prevMode == inputNotes {
+		m.inputArea.CharLimit = textareaCharLimit
+		m.inputArea.MaxHeight = textareaMaxHeight
+	}
 	if prevMode == inputEnvEditValue {
 		m.envEditingFile = nil
 		m.envEditingEntry = envEntry{}
@@ -4832,6 +6306,10 @@ func (m *model) closeInput() {
 	if prevMode == inputEnvNewKey || prevMode == inputEnvNewValue {
 		m.pendingEnvKey = ""
 	}
+	if prevMode == inputCommandConfirm {
+		m.pendingConfirmRun = nil
+		m.pendingConfirmTitle = ""
+	}
 }
 
 func (m *model) openHelpOverlay() {
@@ -4860,7 +6338,124 @@ func (m *model) openCommandPalette() {
 	m.emitTelemetry("palette_opened", map[string]string{})
 }
 
+// openProjectSearch opens the jump-to-project overlay right away and scans
+// the workspace roots in the background, since large roots take a while.
+func (m *model) openProjectSearch() tea.Cmd {
+	m.projectSearchEntries = nil
+	m.projectSearchLoading = true
+	m.inputMode = inputProjectSearch
+	m.inputPrompt = "Jump to project"
+	m.inputActive = true
+	m.filePickerEnabled = false
+	m.textAreaEnabled = false
+	m.inputField.Placeholder = "project name or path"
+	m.inputField.SetValue("")
+	m.inputField.Focus()
+	m.paletteIndex = 0
+	m.updatePaletteMatches("")
+	roots := make([]string, 0, len(m.workspaceRoots))
+	for _, root := range m.workspaceRoots {
+		roots = append(roots, filepath.Clean(root.Path))
+	}
+	return func() tea.Msg {
+		entries, errs := buildProjectSearchEntries(roots)
+		return projectSearchLoadedMsg{entries: entries, errs: errs}
+	}
+}
+
+func (m *model) handleProjectSearchLoaded(msg projectSearchLoadedMsg) {
+	for _, line := range msg.errs {
+		m.appendLog(line)
+	}
+	if m.inputMode != inputProjectSearch || !m.projectSearchLoading {
+		return
+	}
+	m.projectSearchLoading = false
+	m.projectSearchEntries = msg.entries
+	m.updatePaletteMatches(m.inputField.Value())
+	m.emitTelemetry("project_search_opened", map[string]string{
+		"count": strconv.Itoa(len(m.projectSearchEntries)),
+	})
+}
+
+func buildProjectSearchEntries(roots []string) ([]paletteEntry, []string) {
+	seen := make(map[string]bool)
+	var entries []paletteEntry
+	var errs []string
+	for _, rootPath := range roots {
+		if rootPath == "" || !dirExists(rootPath) {
+			continue
+		}
+		projects, err := discoverProjects(rootPath)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("Failed to scan %s: %v", abbreviatePath(rootPath), err))
+			continue
+		}
+		for _, project := range projects {
+			clean := filepath.Clean(project.Path)
+			if seen[clean] {
+				continue
+			}
+			seen[clean] = true
+			entries = append(entries, paletteEntry{
+				label:       project.Name,
+				description: abbreviatePath(clean) + " · " + formatProjectDescription(project.Stats),
+				meta: map[string]string{
+					"action": "jump-project",
+					"path":   clean,
+					"root":   rootPath,
+				},
+			})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].label == entries[j].label {
+			return entries[i].meta["path"] < entries[j].meta["path"]
+		}
+		return entries[i].label < entries[j].label
+	})
+	return entries, errs
+}
+
+func (m *model) executeProjectSearch() tea.Cmd {
+	entry, ok := m.selectedPaletteEntry()
+	if !ok || entry.meta == nil {
+		m.setToast("No matching project", 4*time.Second)
+		return nil
+	}
+	return m.jumpToProject(entry.meta["root"], entry.meta["path"])
+}
+
+func (m *model) jumpToProject(rootPath, projectPath string) tea.Cmd {
+	rootPath = filepath.Clean(rootPath)
+	projectPath = filepath.Clean(projectPath)
+	if m.usingRfpEditor {
+		m.useRfpEditorLayout(false)
+	}
+	root := m.findRoot(rootPath)
+	if root == nil {
+		m.setToast("Workspace root no longer available", 4*time.Second)
+		return nil
+	}
+	if m.currentRoot == nil || filepath.Clean(m.currentRoot.Path) != rootPath {
+		m.currentRoot = root
+		m.refreshProjectsForCurrentRoot()
+	}
+	m.selectWorkspacePath(rootPath)
+	project := m.projectByPath(projectPath)
+	if project == nil {
+		m.appendLog(fmt.Sprintf("Project not found: %s", abbreviatePath(projectPath)))
+		m.setToast("Project not found", 4*time.Second)
+		return nil
+	}
+	m.emitTelemetry("project_jumped", map[string]string{"path": projectPath})
+	return m.handleProjectSelected(project)
+}
+
 func (m *model) startNewProjectFlow(defaultPath string) {
+	if m.rejectInReadOnly() {
+		return
+	}
 	m.pendingNewProjectPath = ""
 	m.pendingNewProjectTemplate = ""
 	m.openInput("New project path", defaultPath, inputNewProjectPath)
@@ -4874,8 +6469,44 @@ func (m *model) startNewProjectFlow(defaultPath string) {
 	}
 }
 
+// openTemplatePrompt lists auto, skip and the templates shipped with the CLI
+// as a filterable picker. Typing a name that is not listed offers it as a
+// custom entry so unknown templates can still be passed through.
 func (m *model) openTemplatePrompt() {
-	m.openInput("Template (auto/skip/<name>)", "auto", inputNewProjectTemplate)
+	m.templateEntries = templatePaletteEntries(discoverProjectTemplates(projectTemplatesDir()))
+	m.openInput("Template", "", inputNewProjectTemplate)
+	m.inputField.Placeholder = "auto"
+	m.paletteIndex = 0
+	m.updatePaletteMatches("")
+}
+
+// selectedTemplate resolves the picker choice, falling back to the typed
+// value and then to auto.
+func (m *model) selectedTemplate(typed string) string {
+	if entry, ok := m.selectedPaletteEntry(); ok && entry.meta != nil && entry.meta["template"] != "" {
+		return entry.meta["template"]
+	}
+	if typed = strings.TrimSpace(typed); typed != "" {
+		return typed
+	}
+	return "auto"
+}
+
+func hasPaletteLabel(entries []paletteEntry, label string) bool {
+	for _, entry := range entries {
+		if strings.EqualFold(entry.label, label) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *model) inputUsesPaletteList() bool {
+	switch m.inputMode {
+	case inputCommandPalette, inputProjectSearch, inputNewProjectTemplate, inputRecentFiles, inputSettingsFeatures:
+		return true
+	}
+	return false
 }
 
 func (m *model) launchCreateProject(path string, template string) tea.Cmd {
@@ -4888,6 +6519,11 @@ func (m *model) launchCreateProject(path string, template string) tea.Cmd {
 	}
 
 	args := []string{"create-project"}
+	// The new project has no policy yet; the selected project's applies.
+	if !m.currentCommandPolicy().allows("", args) {
+		m.blockDeniedCommand(args[0])
+		return nil
+	}
 	trimmedTpl := strings.TrimSpace(template)
 	if trimmedTpl != "" && trimmedTpl != "auto" {
 		args = append(args, "--template", trimmedTpl)
@@ -4895,7 +6531,17 @@ func (m *model) launchCreateProject(path string, template string) tea.Cmd {
 	args = append(args, resolved)
 
 	title := fmt.Sprintf("create-project %s", filepath.Base(resolved))
-	m.appendLog(fmt.Sprintf("Queued %s", title))
+	if m.commandNeedsConfirm("", args) {
+		m.requestCommandConfirm(title, args, func() tea.Cmd {
+			return m.queueCreateProject(title, resolved, parent, trimmedTpl, args)
+		})
+		return nil
+	}
+	return m.queueCreateProject(title, resolved, parent, trimmedTpl, args)
+}
+
+func (m *model) queueCreateProject(title, resolved, parent, trimmedTpl string, args []string) tea.Cmd {
+	m.appendLog(m.queuedLogLine(title))
 	m.appendLog(fmt.Sprintf("Command: gpt-creator %s", strings.Join(args, " ")))
 	m.showLogs = true
 	m.emitTelemetry("create_project_started", map[string]string{
@@ -4904,6 +6550,7 @@ func (m *model) launchCreateProject(path string, template string) tea.Cmd {
 		"template": trimmedTpl,
 		"feature":  "projects",
 	})
+	m.recordSessionCommand(parent, args)
 	if m.createProjectJobs == nil {
 		m.createProjectJobs = make(map[string]string)
 	}
@@ -4934,12 +6581,31 @@ func (m *model) launchCreateProject(path string, template string) tea.Cmd {
 }
 
 func (m *model) enqueueJob(req jobRequest) tea.Cmd {
+	if m.rejectInReadOnly() {
+		m.appendLog(fmt.Sprintf("Read-only mode: not running %s", req.title))
+		return nil
+	}
+	if req.command == "gpt-creator" && m.dryRunCommand(req.title, req.dir, req.args) {
+		return nil
+	}
+	// Remember the request before the settings-derived env is added so a
+	// re-run picks up the current settings instead of repeating them.
+	if req.command == "gpt-creator" {
+		last := req
+		last.env = append([]string(nil), req.env...)
+		m.lastJob = &last
+		m.lastJobEnv = append([]string(nil), m.nextJobEnv...)
+	}
 	if strings.TrimSpace(m.settingsDockerPath) != "" {
 		req.env = append(req.env, "GC_DOCKER_BIN="+strings.TrimSpace(m.settingsDockerPath))
 	}
 	if m.settingsConcurrency > 0 {
 		req.env = append(req.env, fmt.Sprintf("GC_MAX_CONCURRENCY=%d", m.settingsConcurrency))
 	}
+	if len(m.nextJobEnv) > 0 {
+		req.env = append(req.env, m.nextJobEnv...)
+		m.nextJobEnv = nil
+	}
 	if m.jobRunner == nil {
 		m.jobRunner = newJobManager()
 	}
@@ -4957,6 +6623,7 @@ func (m *model) enqueueJob(req jobRequest) tea.Cmd {
 	}
 	status := m.ensureJobStatus(id, req.title)
 	status.Status = "Queued"
+	status.Dir = req.dir
 	status.Started = time.Time{}
 	status.Ended = time.Time{}
 	status.Err = ""
@@ -4965,6 +6632,67 @@ func (m *model) enqueueJob(req jobRequest) tea.Cmd {
 	return cmd
 }
 
+// rerunLastJob queues the most recent gpt-creator command again with the same
+// directory, arguments and one-off env overrides.
+func (m *model) rerunLastJob() tea.Cmd {
+	if m.lastJob == nil {
+		m.setToast("No command to re-run yet", 4*time.Second)
+		return nil
+	}
+	req := *m.lastJob
+	req.args = append([]string(nil), req.args...)
+	req.env = append([]string(nil), req.env...)
+	overrides := append([]string(nil), m.lastJobEnv...)
+	queue := func() tea.Cmd {
+		m.appendLog(fmt.Sprintf("Re-running: %s", req.title))
+		m.appendLog(fmt.Sprintf("Command: gpt-creator %s", strings.Join(req.args, " ")))
+		if len(overrides) > 0 {
+			m.appendLog("Env overrides: " + describeEnvOverrides(overrides))
+		}
+		m.showLogs = true
+		m.recordSessionCommand(req.dir, req.args)
+		pending := m.nextJobEnv
+		m.nextJobEnv = overrides
+		m.setToast("Re-running "+req.title, 3*time.Second)
+		cmd := m.enqueueJob(req)
+		if m.nextJobEnv != nil {
+			// Not consumed (dry run or read-only); keep the user's own overrides.
+			m.nextJobEnv = pending
+		}
+		return cmd
+	}
+	if m.commandNeedsConfirm("", req.args) {
+		m.requestCommandConfirm(req.title, req.args, queue)
+		return nil
+	}
+	return queue()
+}
+
+// setNextJobEnv stores the overrides for the next enqueued job, or clears
+// them when value is blank. It reports whether value was accepted.
+func (m *model) setNextJobEnv(value string) bool {
+	overrides, err := parseEnvOverrides(value)
+	if err != nil {
+		m.setToast(err.Error(), 4*time.Second)
+		return false
+	}
+	m.nextJobEnv = overrides
+	if len(overrides) == 0 {
+		m.setToast("Env overrides cleared", 3*time.Second)
+		return true
+	}
+	m.appendLog(fmt.Sprintf("Env for next command: %s", describeEnvOverrides(overrides)))
+	m.setToast(fmt.Sprintf("%d env override(s) apply to the next command", len(overrides)), 4*time.Second)
+	return true
+}
+
+func (m *model) queuedLogLine(title string) string {
+	if len(m.nextJobEnv) == 0 {
+		return fmt.Sprintf("Queued %s", title)
+	}
+	return fmt.Sprintf("Queued %s (env: %s)", title, describeEnvOverrides(m.nextJobEnv))
+}
+
 func (m *model) ensureJobStatus(id int, title string) *jobStatus {
 	if m.jobStatuses == nil {
 		m.jobStatuses = make(map[int]*jobStatus)
@@ -4981,6 +6709,65 @@ func (m *model) ensureJobStatus(id int, title string) *jobStatus {
 	return status
 }
 
+// lastFinishedJob returns the most recently ended job still in the history.
+func (m *model) lastFinishedJob() *jobStatus {
+	var last *jobStatus
+	for _, id := range m.jobOrder {
+		status := m.jobStatuses[id]
+		if status == nil || status.Ended.IsZero() {
+			continue
+		}
+		if last == nil || status.Ended.After(last.Ended) {
+			last = status
+		}
+	}
+	return last
+}
+
+// captureLastJobReport saves the output of the last finished job into the
+// reports/ folder of the project it ran in, or the selected project when the
+// job ran elsewhere.
+func (m *model) captureLastJobReport() tea.Cmd {
+	if m.rejectInReadOnly() {
+		return nil
+	}
+	status := m.lastFinishedJob()
+	if status == nil {
+		m.setToast("No finished job to capture", 4*time.Second)
+		return nil
+	}
+	projectPath := ""
+	if project := m.projectByPath(status.Dir); project != nil {
+		projectPath = project.Path
+	} else if m.currentProject != nil {
+		projectPath = m.currentProject.Path
+	}
+	if projectPath == "" {
+		m.setToast("Select a project to save the report into", 4*time.Second)
+		return nil
+	}
+	path, err := writeJobOutputReport(projectPath, *status, status.Output)
+	if err != nil {
+		m.appendLog(fmt.Sprintf("Unable to save job report: %v", err))
+		m.setToast("Unable to save job report", 4*time.Second)
+		return nil
+	}
+	rel := relativePath(projectPath, path)
+	m.emitTelemetry("report_captured", map[string]string{
+		"path":   filepath.Clean(projectPath),
+		"report": rel,
+		"title":  status.Title,
+		"status": strings.ToLower(status.Status),
+		"lines":  strconv.Itoa(len(status.Output)),
+	})
+	m.appendLog("Saved job output: " + rel)
+	m.setToast("Saved "+rel, 4*time.Second)
+	if m.currentFeature == "reports" && m.currentProject != nil && filepath.Clean(m.currentProject.Path) == filepath.Clean(projectPath) {
+		return m.loadReportsEntriesCmd()
+	}
+	return nil
+}
+
 func (m *model) pruneJobHistory() {
 	const maxJobs = 12
 	if len(m.jobOrder) <= maxJobs {
@@ -5160,10 +6947,31 @@ func (m *model) refreshCommandCatalog() {
 			seen[key] = entry
 		}
 	}
+	for _, cmd := range m.discoveredCommands {
+		key := strings.Join(cmd.Command, " ")
+		if _, ok := seen[key]; !ok {
+			seen[key] = discoveredPaletteEntry(cmd)
+		}
+	}
+	policy := m.currentCommandPolicy()
 	entries := make([]paletteEntry, 0, len(seen)+4)
 	for _, entry := range seen {
+		if !policy.allows("", entry.command) {
+			continue
+		}
 		entries = append(entries, entry)
 	}
+	for _, def := range featureDefinitions {
+		entries = append(entries, paletteEntry{
+			label:           "Go to: " + def.Title,
+			description:     def.Desc,
+			requiresProject: true,
+			meta: map[string]string{
+				"action":  "goto-feature",
+				"feature": def.Key,
+			},
+		})
+	}
 	currentTheme := m.markdownTheme
 	entries = append(entries,
 		paletteEntry{
@@ -5190,6 +6998,100 @@ func (m *model) refreshCommandCatalog() {
 				"theme":  markdownThemeLight.String(),
 			},
 		},
+		paletteEntry{
+			label:       "Markdown Theme: High contrast",
+			description: themePaletteDescription(markdownThemeHighContrast, currentTheme),
+			meta: map[string]string{
+				"action": "set-markdown-theme",
+				"theme":  markdownThemeHighContrast.String(),
+			},
+		},
+		paletteEntry{
+			label:       "Reload project from disk",
+			description: "Rescan the selected project and refresh the current view",
+			meta: map[string]string{
+				"action": "reload-project",
+			},
+		},
+		paletteEntry{
+			label:       "Save last job output as report",
+			description: "Write the most recent finished job's output to reports/" + jobReportDir + "/",
+			meta: map[string]string{
+				"action": "capture-job-report",
+			},
+		},
+		paletteEntry{
+			label:       "Check Docker readiness",
+			description: "Run docker info and docker compose version",
+			meta: map[string]string{
+				"action": "check-docker",
+			},
+		},
+		paletteEntry{
+			label:       "About / diagnostics",
+			description: "Show CLI version, docker, config paths and project count",
+			meta: map[string]string{
+				"action": "show-diagnostics",
+			},
+		},
+		paletteEntry{
+			label:           "Recent files",
+			description:     "Reopen a file recently opened in the editor",
+			requiresProject: true,
+			meta: map[string]string{
+				"action": "recent-files",
+			},
+		},
+		paletteEntry{
+			label:           "Attach RFP",
+			description:     "Copy an RFP file into the project's staging/inputs/",
+			requiresProject: true,
+			meta: map[string]string{
+				"action": "attach-rfp",
+			},
+		},
+		paletteEntry{
+			label:       "Discover CLI commands",
+			description: "Read gpt-creator --help and add commands missing from the palette",
+			meta: map[string]string{
+				"action": "discover-commands",
+			},
+		},
+		paletteEntry{
+			label:       "Clear logs",
+			description: "Empty the log panel, keeping the job queue",
+			meta: map[string]string{
+				"action": "clear-logs",
+			},
+		},
+		paletteEntry{
+			label:       "Set env for next command",
+			description: "Pass one-off KEY=VALUE variables to the next launched command",
+			meta: map[string]string{
+				"action": "set-job-env",
+			},
+		},
+		paletteEntry{
+			label:       "Export settings",
+			description: "Write pins, roots, theme and other UI settings to a file",
+			meta: map[string]string{
+				"action": "export-settings",
+			},
+		},
+		paletteEntry{
+			label:       "Import settings",
+			description: "Load UI settings exported on another machine",
+			meta: map[string]string{
+				"action": "import-settings",
+			},
+		},
+		paletteEntry{
+			label:       "Copy session commands",
+			description: "Copy the commands queued this session as a shell script",
+			meta: map[string]string{
+				"action": "copy-session-commands",
+			},
+		},
 		paletteEntry{
 			label:       "Markdown Theme: Toggle",
 			description: fmt.Sprintf("Cycle Markdown theme (current: %s)", markdownThemeLabel(currentTheme)),
@@ -5205,6 +7107,20 @@ func (m *model) refreshCommandCatalog() {
 	m.updatePaletteMatches(m.inputField.Value())
 }
 
+// currentCommandPolicy returns the command allow/deny policy of the selected
+// project, or an empty policy when none is selected.
+func (m *model) currentCommandPolicy() commandPolicy {
+	if m.currentProject == nil {
+		return commandPolicy{}
+	}
+	return loadCommandPolicy(m.currentProject.Path)
+}
+
+func (m *model) blockDeniedCommand(command string) {
+	m.appendLog(fmt.Sprintf("Command blocked by %s: gpt-creator %s", abbreviatePath(commandPolicyPath(m.currentProject.Path)), command))
+	m.setToast("Command not allowed in this project", 5*time.Second)
+}
+
 func themePaletteDescription(theme, current markdownTheme) string {
 	suffix := ""
 	if theme == current {
@@ -5215,7 +7131,30 @@ func themePaletteDescription(theme, current markdownTheme) string {
 
 func (m *model) updatePaletteMatches(query string) {
 	q := strings.ToLower(strings.TrimSpace(query))
-	if len(m.commandEntries) == 0 {
+	source := m.commandEntries
+	scoreFn := paletteScore
+	if m.inputMode == inputProjectSearch {
+		source = m.projectSearchEntries
+		scoreFn = projectSearchScore
+	}
+	if m.inputMode == inputRecentFiles {
+		source = m.recentFileEntries
+	}
+	if m.inputMode == inputSettingsFeatures {
+		source = m.featureVisibilityEntries
+	}
+	var custom []paletteEntry
+	if m.inputMode == inputNewProjectTemplate {
+		source = m.templateEntries
+		if name := strings.TrimSpace(query); name != "" && !hasPaletteLabel(source, name) {
+			custom = append(custom, paletteEntry{
+				label:       name,
+				description: "use this template name",
+				meta:        map[string]string{"template": name},
+			})
+		}
+	}
+	if len(source) == 0 {
 		m.paletteMatches = nil
 		m.paletteIndex = 0
 		m.palettePaginator.Page = 0
@@ -5223,7 +7162,7 @@ func (m *model) updatePaletteMatches(query string) {
 		return
 	}
 	if q == "" {
-		m.paletteMatches = append([]paletteEntry(nil), m.commandEntries...)
+		m.paletteMatches = append([]paletteEntry(nil), source...)
 		m.paletteIndex = 0
 		m.palettePaginator.Page = 0
 		m.configurePalettePaginator()
@@ -5235,8 +7174,8 @@ func (m *model) updatePaletteMatches(query string) {
 		score int
 	}
 	var scoredMatches []scored
-	for _, entry := range m.commandEntries {
-		score := paletteScore(entry, q)
+	for _, entry := range source {
+		score := scoreFn(entry, q)
 		if score >= 0 {
 			scoredMatches = append(scoredMatches, scored{entry: entry, score: score})
 		}
@@ -5251,6 +7190,7 @@ func (m *model) updatePaletteMatches(query string) {
 	for _, item := range scoredMatches {
 		m.paletteMatches = append(m.paletteMatches, item.entry)
 	}
+	m.paletteMatches = append(m.paletteMatches, custom...)
 	if len(m.paletteMatches) == 0 {
 		m.paletteIndex = 0
 	}
@@ -5274,6 +7214,54 @@ func paletteScore(entry paletteEntry, query string) int {
 	return -1
 }
 
+func projectSearchScore(entry paletteEntry, query string) int {
+	label := strings.ToLower(entry.label)
+	path := ""
+	if entry.meta != nil {
+		path = strings.ToLower(entry.meta["path"])
+	}
+	if idx := strings.Index(label, query); idx >= 0 {
+		return idx
+	}
+	if idx := strings.Index(path, query); idx >= 0 {
+		return idx + 50
+	}
+	if gaps := fuzzySubsequenceGaps(label, query); gaps >= 0 {
+		return gaps + 100
+	}
+	if gaps := fuzzySubsequenceGaps(path, query); gaps >= 0 {
+		return gaps + 200
+	}
+	return -1
+}
+
+func fuzzySubsequenceGaps(text, query string) int {
+	if query == "" {
+		return 0
+	}
+	qr := []rune(query)
+	qi := 0
+	gaps := 0
+	last := -1
+	for i, r := range []rune(text) {
+		if qi >= len(qr) {
+			break
+		}
+		if r != qr[qi] {
+			continue
+		}
+		if last >= 0 {
+			gaps += i - last - 1
+		}
+		last = i
+		qi++
+	}
+	if qi < len(qr) {
+		return -1
+	}
+	return gaps
+}
+
 func (m *model) movePaletteSelection(delta int) {
 	if len(m.paletteMatches) == 0 {
 		m.paletteIndex = 0
@@ -5393,6 +7381,33 @@ func (m *model) runPaletteEntry(entry paletteEntry) tea.Cmd {
 				m.cycleThemeSetting(1)
 			case "set-markdown-theme":
 				m.setThemeSetting(markdownThemeFromString(entry.meta["theme"]))
+			case "copy-session-commands":
+				m.copySessionCommands()
+			case "clear-logs":
+				m.clearLogs()
+			case "set-job-env":
+				m.openInput("Env for next command (KEY=VALUE …, empty clears)", strings.Join(m.nextJobEnv, " "), inputJobEnv)
+			case "export-settings":
+				return m.openPathPicker("Export settings to (directory or file)", "", inputSettingsExport, true, true)
+			case "import-settings":
+				return m.openPathPicker("Import settings from", "", inputSettingsImport, false, true)
+			case "reload-project":
+				return m.reloadCurrentProject()
+			case "show-diagnostics":
+				return m.showDiagnostics()
+			case "capture-job-report":
+				return m.captureLastJobReport()
+			case "check-docker":
+				return m.startDockerCheck()
+			case "attach-rfp":
+				return m.startAttachRFP()
+			case "recent-files":
+				m.openRecentFiles()
+			case "discover-commands":
+				m.setToast("Reading gpt-creator --help…", 3*time.Second)
+				return discoverCLICommands()
+			case "goto-feature":
+				return m.gotoFeature(entry.meta["feature"])
 			}
 		}
 		return nil
@@ -5401,6 +7416,10 @@ func (m *model) runPaletteEntry(entry paletteEntry) tea.Cmd {
 		m.appendLog("Select a project before running this command.")
 		return nil
 	}
+	if !m.currentCommandPolicy().allows("", entry.command) {
+		m.blockDeniedCommand(strings.Join(entry.command, " "))
+		return nil
+	}
 	requiresDocker := entry.meta != nil && entry.meta["requiresDocker"] == "1"
 	if !requiresDocker && len(entry.command) > 0 {
 		if entry.command[0] == "run" || entry.command[0] == "verify" {
@@ -5408,8 +7427,8 @@ func (m *model) runPaletteEntry(entry paletteEntry) tea.Cmd {
 		}
 	}
 	if requiresDocker && !m.dockerAvailable {
-		m.appendLog("Docker CLI not available; install Docker Desktop to run this command.")
-		m.setToast("Docker required for this command", 5*time.Second)
+		m.appendLog(m.dockerUnavailableMessage() + "; install or start Docker Desktop to run this command.")
+		m.setToast("Docker required: "+m.dockerUnavailableMessage(), 5*time.Second)
 		return nil
 	}
 	args := append([]string{}, entry.command...)
@@ -5430,35 +7449,45 @@ func (m *model) runPaletteEntry(entry paletteEntry) tea.Cmd {
 	if m.currentProject != nil {
 		dir = m.currentProject.Path
 	}
-
-	m.appendLog(fmt.Sprintf("Queued %s", entry.label))
-	if entry.description != "" {
-		m.appendLog(entry.description)
+	queue := func() tea.Cmd {
+		m.appendLog(m.queuedLogLine(entry.label))
+		if entry.description != "" {
+			m.appendLog(entry.description)
+		}
+		m.appendLog(fmt.Sprintf("Command: gpt-creator %s", strings.Join(args, " ")))
+		m.showLogs = true
+		fields := map[string]string{"command": strings.Join(entry.command, " ")}
+		if m.currentProject != nil {
+			fields["project"] = filepath.Clean(m.currentProject.Path)
+		}
+		m.emitTelemetry("command_queued", fields)
+		m.recordSessionCommand(dir, args)
+
+		identifier := strings.Join(entry.command, " ")
+		return m.enqueueJob(jobRequest{
+			title:   entry.label,
+			dir:     dir,
+			command: "gpt-creator",
+			args:    args,
+			onFinish: func(err error) {
+				if err == nil && (strings.HasPrefix(identifier, "generate") || strings.HasPrefix(identifier, "verify")) {
+					m.refreshProjectsForCurrentRoot()
+				}
+			},
+		})
 	}
-	m.appendLog(fmt.Sprintf("Command: gpt-creator %s", strings.Join(args, " ")))
-	m.showLogs = true
-	fields := map[string]string{"command": strings.Join(entry.command, " ")}
-	if m.currentProject != nil {
-		fields["project"] = filepath.Clean(m.currentProject.Path)
+	if m.commandNeedsConfirm("", entry.command) {
+		m.requestCommandConfirm(entry.label, args, queue)
+		return nil
 	}
-	m.emitTelemetry("command_queued", fields)
-
-	identifier := strings.Join(entry.command, " ")
-	return m.enqueueJob(jobRequest{
-		title:   entry.label,
-		dir:     dir,
-		command: "gpt-creator",
-		args:    args,
-		onFinish: func(err error) {
-			if err == nil && (strings.HasPrefix(identifier, "generate") || strings.HasPrefix(identifier, "verify")) {
-				m.refreshProjectsForCurrentRoot()
-			}
-		},
-	})
+	return queue()
 }
 
 func (m *model) renderPaletteMatches(width int) string {
 	if len(m.paletteMatches) == 0 {
+		if m.inputMode == inputProjectSearch && m.projectSearchLoading {
+			return "Scanning workspace roots…"
+		}
 		return "No matches"
 	}
 	if width < 10 {
@@ -5480,6 +7509,16 @@ func (m *model) renderPaletteMatches(width int) string {
 		}
 	}
 	headerParts := []string{"↑/↓ select", "Enter run", "Esc cancel"}
+	if m.inputMode == inputProjectSearch || m.inputMode == inputRecentFiles {
+		headerParts[1] = "Enter open"
+	}
+	if m.inputMode == inputNewProjectTemplate {
+		headerParts[1] = "Enter choose"
+	}
+	if m.inputMode == inputSettingsFeatures {
+		headerParts[1] = "Enter show/hide"
+		headerParts[2] = "Esc done"
+	}
 	if m.palettePaginator.TotalPages > 1 {
 		headerParts = append(headerParts, fmt.Sprintf("←/→ page %s", m.palettePaginator.View()))
 	}
@@ -5588,6 +7627,10 @@ func (m *model) runItemCommand(item featureItemDefinition) tea.Cmd {
 		m.appendLog("Select a project before running commands.")
 		return nil
 	}
+	if !m.currentCommandPolicy().allows(item.Key, item.Command) {
+		m.blockDeniedCommand(strings.Join(item.Command, " "))
+		return nil
+	}
 	requiresDocker := item.Meta != nil && item.Meta["requiresDocker"] == "1"
 	if !requiresDocker {
 		if strings.HasPrefix(item.Key, "run-") || strings.HasPrefix(item.Key, "verify-") {
@@ -5595,8 +7638,8 @@ func (m *model) runItemCommand(item featureItemDefinition) tea.Cmd {
 		}
 	}
 	if requiresDocker && !m.dockerAvailable {
-		m.appendLog("Docker CLI not available; install Docker Desktop to run this command.")
-		m.setToast("Docker required for this command", 5*time.Second)
+		m.appendLog(m.dockerUnavailableMessage() + "; install or start Docker Desktop to run this command.")
+		m.setToast("Docker required: "+m.dockerUnavailableMessage(), 5*time.Second)
 		return nil
 	}
 
@@ -5610,9 +7653,20 @@ func (m *model) runItemCommand(item featureItemDefinition) tea.Cmd {
 	}
 
 	title := fmt.Sprintf("%s • %s", item.Title, m.currentProject.Name)
-	m.appendLog(fmt.Sprintf("Queued %s", title))
+	if m.commandNeedsConfirm(item.Key, item.Command) {
+		m.requestCommandConfirm(title, args, func() tea.Cmd {
+			return m.queueItemCommand(item, title, m.currentProject.Path, args)
+		})
+		return nil
+	}
+	return m.queueItemCommand(item, title, m.currentProject.Path, args)
+}
+
+func (m *model) queueItemCommand(item featureItemDefinition, title, projectPath string, args []string) tea.Cmd {
+	m.appendLog(m.queuedLogLine(title))
 	m.appendLog(fmt.Sprintf("Command: gpt-creator %s", strings.Join(args, " ")))
 	m.showLogs = true
+	m.recordSessionCommand(projectPath, args)
 	itemKey := item.Key
 	isVerifyAll := itemKey == "overview-run-verify-all" || itemKey == "verify-all"
 	isGenerate := strings.HasPrefix(itemKey, "generate-") || itemKey == "generate-all"
@@ -5673,10 +7727,10 @@ func (m *model) runItemCommand(item featureItemDefinition) tea.Cmd {
 			snapshotTargets = append(snapshotTargets, targetLabel)
 		}
 	}
-	path := filepath.Clean(m.currentProject.Path)
+	path := filepath.Clean(projectPath)
 	req := jobRequest{
 		title:   title,
-		dir:     m.currentProject.Path,
+		dir:     projectPath,
 		command: "gpt-creator",
 		args:    args,
 	}
@@ -5784,6 +7838,9 @@ func (m *model) runItemCommand(item featureItemDefinition) tea.Cmd {
 			m.emitTelemetry(event, fields)
 			if err == nil {
 				m.refreshCurrentFeatureItemsFor(path)
+				if m.settingsAutoVerify {
+					m.autoVerifyPending = path
+				}
 			}
 		}
 	}
@@ -5863,6 +7920,145 @@ func (m *model) handleDocItemSelection(item featureItemDefinition, activate bool
 	return cmd
 }
 
+// openNotesEditor edits the project's notes in the textarea overlay. Notes
+// have no length limit, and closing the overlay saves them.
+func (m *model) openNotesEditor() {
+	if m.currentProject == nil || m.rejectInReadOnly() {
+		return
+	}
+	notes, err := readProjectNotes(m.currentProject)
+	if err != nil {
+		m.appendLog(fmt.Sprintf("Unable to read notes: %v", err))
+		m.setToast("Unable to read notes", 4*time.Second)
+		return
+	}
+	m.inputArea.CharLimit = 0
+	m.inputArea.MaxHeight = 0
+	m.openTextarea("Notes — "+m.currentProject.Name, notes, inputNotes)
+}
+
+func (m *model) saveProjectNotes(content string) {
+	if m.currentProject == nil {
+		return
+	}
+	changed, err := writeProjectNotes(m.currentProject, content)
+	if err != nil {
+		m.appendLog(fmt.Sprintf("Unable to save notes: %v", err))
+		m.setToast("Unable to save notes", 4*time.Second)
+		return
+	}
+	if !changed {
+		return
+	}
+	m.emitTelemetry("notes_saved", map[string]string{
+		"path":  filepath.Clean(m.currentProject.Path),
+		"bytes": strconv.Itoa(len(content)),
+	})
+	m.appendLog("Saved notes: " + notesRelPath)
+	m.setToast("Notes saved", 3*time.Second)
+	if m.currentFeature == "notes" {
+		m.previewCol.SetMarkdownContent(renderNotesPreview(m.currentProject))
+	}
+}
+
+func (m *model) toggleDocsRawMarkdown() {
+	m.docsRawMarkdown = !m.docsRawMarkdown
+	m.previewCol.SetRaw(m.docsRawMarkdown)
+	m.writeUIConfig()
+	m.setToast(ternary(m.docsRawMarkdown, "Docs preview: raw markdown", "Docs preview: rendered markdown"), 3*time.Second)
+}
+
+// toggleDocTOC swaps the document list for a table of contents built from the
+// previewed document's headings. Moving through it scrolls the preview.
+func (m *model) toggleDocTOC() {
+	if m.docTOCVisible {
+		m.docTOCVisible = false
+		m.updateVisibleColumns()
+		m.setFocusArea(focusItems)
+		return
+	}
+	if strings.TrimSpace(m.currentDocRelPath) == "" || !m.shouldShowPreviewColumn() {
+		m.setToast("Open a document first", 4*time.Second)
+		return
+	}
+	headings := m.previewCol.MarkdownHeadings()
+	if len(headings) == 0 {
+		m.setToast("No headings in this document", 4*time.Second)
+		return
+	}
+	items := make([]list.Item, 0, len(headings))
+	for i, heading := range headings {
+		items = append(items, listEntry{
+			title:   strings.Repeat("  ", max(heading.Level-1, 0)) + heading.Text,
+			payload: i,
+		})
+	}
+	m.docTOCCol.SetItems(items)
+	m.docTOCVisible = true
+	m.updateVisibleColumns()
+	m.setFocusArea(focusItems)
+}
+
+func (m *model) scrollDocToHeading(entry listEntry) {
+	index, ok := entry.payload.(int)
+	if !ok {
+		return
+	}
+	if line := m.previewCol.HeadingLine(m.previewCol.MarkdownHeadings(), index); line >= 0 {
+		m.previewCol.ScrollToLine(line)
+	}
+}
+
+func (m *model) promptDocDiffBase() {
+	if m.currentProject == nil || strings.TrimSpace(m.currentDocRelPath) == "" {
+		m.setToast("Select a document first", 4*time.Second)
+		return
+	}
+	m.openInput("Diff against revision (commit, branch or tag)", ternary(m.docDiffRevision != "", m.docDiffRevision, "HEAD"), inputDocDiffBase)
+}
+
+// showDocRevisionDiff renders the selected document against rev in the
+// preview, falling back to the generate snapshot outside git repositories.
+func (m *model) showDocRevisionDiff(rev string) {
+	if m.currentProject == nil {
+		m.appendLog("Select a project before diffing documentation.")
+		return
+	}
+	rel := strings.TrimSpace(m.currentDocRelPath)
+	if rel == "" {
+		m.setToast("Select a document first", 4*time.Second)
+		return
+	}
+	if rev == "" {
+		rev = "HEAD"
+	}
+	if strings.HasPrefix(rev, "-") {
+		m.setToast("Invalid revision: "+rev, 5*time.Second)
+		return
+	}
+	content, source, err := renderDocRevisionDiff(m.currentProject.Path, rel, rev)
+	if err != nil {
+		m.appendLog(fmt.Sprintf("Doc diff failed for %s: %v", rel, err))
+		m.setToast("Doc diff unavailable", 5*time.Second)
+		return
+	}
+	m.previewCol.SetRaw(false)
+	m.previewCol.SetContent(content)
+	m.setToast("Showing diff for "+rel, 3*time.Second)
+	fields := map[string]string{
+		"path":     filepath.Clean(m.currentProject.Path),
+		"document": rel,
+		"source":   source,
+	}
+	if source == generateDiffSourceGit {
+		fields["base"] = rev
+	}
+	if m.currentDocType != "" {
+		fields["doc_type"] = m.currentDocType
+	}
+	m.emitTelemetry("doc_diff_viewed", fields)
+}
+
 func (m *model) recordDocPreviewTelemetry(item featureItemDefinition) {
 	if m.currentProject == nil || item.Meta == nil {
 		return
@@ -5901,6 +8097,83 @@ func (m *model) handleVerifyItemSelection(item featureItemDefinition) {
 	m.recordVerifyPreviewTelemetry(item)
 }
 
+// copyVerifyCheckDetails copies the selected check's status, message and
+// report/log paths to the clipboard.
+func (m *model) copyVerifyCheckDetails() {
+	if m.currentProject == nil {
+		return
+	}
+	item, ok := m.itemsCol.SelectedItem()
+	if !ok || item.Meta == nil || item.Meta["verifyName"] == "" {
+		m.setToast("Select a verify check first", 4*time.Second)
+		return
+	}
+	projectPath := filepath.Clean(m.currentProject.Path)
+	details := verifyCheckDetailsText(projectPath, item.Meta)
+	if err := clipboard.WriteAll(details); err != nil {
+		m.appendLog(fmt.Sprintf("Failed to copy verify details: %v", err))
+		m.setToast("Clipboard unavailable", 4*time.Second)
+		return
+	}
+	name := item.Meta["verifyName"]
+	m.emitTelemetry("verify_details_copied", map[string]string{
+		"path":   projectPath,
+		"check":  name,
+		"status": normalizeVerifyStatus(item.Meta["verifyStatus"]),
+	})
+	m.setToast(fmt.Sprintf("Copied %s check details", fallback(item.Meta["verifyLabel"], name)), 3*time.Second)
+}
+
+// rerunFailedVerifyChecks queues `verify <name>` for every check whose last
+// recorded status is a failure. Results stream back through ::verify:: events.
+func (m *model) rerunFailedVerifyChecks() tea.Cmd {
+	if m.currentProject == nil {
+		m.appendLog("Select a project before running commands.")
+		return nil
+	}
+	failed := failedVerifyChecks(verifySummaryForProject(m.currentProject))
+	if len(failed) == 0 {
+		m.setToast("No failing verify checks", 3*time.Second)
+		return nil
+	}
+	prevItem := m.currentItem
+	defer func() { m.currentItem = prevItem }()
+	var (
+		cmds  []tea.Cmd
+		names []string
+	)
+	for _, check := range failed {
+		def, ok := verifyDefinitionByName(check.Name)
+		if !ok || len(def.Command) == 0 {
+			m.appendLog(fmt.Sprintf("No verify command known for %s; skipping.", check.Name))
+			continue
+		}
+		meta := map[string]string{"verifyName": check.Name}
+		if def.RequiresDocker {
+			meta["requiresDocker"] = "1"
+		}
+		item := featureItemDefinition{
+			Key:             "verify-check-" + strings.ReplaceAll(check.Name, "/", "-"),
+			Title:           "verify " + check.Name,
+			Command:         append([]string{}, def.Command...),
+			ProjectRequired: true,
+			Meta:            meta,
+		}
+		names = append(names, check.Name)
+		if cmd := m.runItemCommand(item); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	if len(names) > 0 {
+		m.emitTelemetry("verify_failed_rerun", map[string]string{
+			"path":    filepath.Clean(m.currentProject.Path),
+			"feature": "verify",
+			"checks":  strings.Join(names, ","),
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
 func (m *model) recordVerifyPreviewTelemetry(item featureItemDefinition) {
 	if m.currentProject == nil || item.Meta == nil {
 		return
@@ -5950,29 +8223,102 @@ func (m *model) handleGenerateItemSelection(item featureItemDefinition, activate
 	}
 }
 
-func (m *model) handleDatabaseItemSelection(item featureItemDefinition) {
-	m.currentDBSchemaPath = ""
-	m.currentDBSeedPath = ""
+func (m *model) selectedGenerateChange() (generateFileChange, bool) {
 	if m.currentProject == nil {
+		return generateFileChange{}, false
+	}
+	change, ok := generateFileChangeFromItem(m.currentItem)
+	if !ok {
+		m.setToast("Select a generated file first", 4*time.Second)
+	}
+	return change, ok
+}
+
+func (m *model) keepSelectedGenerateFile() {
+	change, ok := m.selectedGenerateChange()
+	if !ok || m.rejectInReadOnly() {
 		return
 	}
-	info := gatherDatabaseDumpInfo(m.currentProject.Path)
-	if info.Found {
-		for _, file := range info.Files {
-			switch file.Kind {
-			case "schema":
-				m.currentDBSchemaPath = file.Path
-			case "seed":
-				m.currentDBSeedPath = file.Path
-			}
-		}
+	projectPath := m.currentProject.Path
+	if err := keepGenerateFile(projectPath, change); err != nil {
+		m.appendLog(fmt.Sprintf("Failed to keep %s: %v", change.Path, err))
+		m.setToast("Keep failed", 5*time.Second)
+		return
 	}
-	if item.Meta != nil {
-		if m.currentDBSchemaPath == "" {
-			if rel := strings.TrimSpace(item.Meta["dbSchemaRel"]); rel != "" {
-				m.currentDBSchemaPath = filepath.Join(m.currentProject.Path, filepath.FromSlash(rel))
-			}
-		}
+	m.appendLog(fmt.Sprintf("Kept generated file %s (%s)", change.Path, change.DiffSource))
+	if change.DiffSource == generateDiffSourceGit {
+		// Git keeps stay listed, labelled staged, until they are committed.
+		m.setToast("Staged "+change.Path, 3*time.Second)
+	} else {
+		m.setToast("Kept "+change.Path, 3*time.Second)
+	}
+	m.emitGenerateReviewTelemetry("keep", change)
+	m.refreshCurrentFeatureItemsFor(projectPath)
+}
+
+func (m *model) confirmRevertGenerateFile() {
+	change, ok := m.selectedGenerateChange()
+	if !ok || m.rejectInReadOnly() {
+		return
+	}
+	projectPath := m.currentProject.Path
+	action := "revert"
+	if change.Status == "added" {
+		action = "delete"
+	}
+	m.pendingConfirmTitle = fmt.Sprintf("%s %s", action, change.Path)
+	m.pendingConfirmRun = func() tea.Cmd {
+		if err := revertGenerateFile(projectPath, change); err != nil {
+			m.appendLog(fmt.Sprintf("Failed to revert %s: %v", change.Path, err))
+			m.setToast("Revert failed", 5*time.Second)
+			return nil
+		}
+		m.appendLog(fmt.Sprintf("Reverted generated file %s (%s)", change.Path, change.DiffSource))
+		m.setToast("Reverted "+change.Path, 3*time.Second)
+		m.emitGenerateReviewTelemetry("revert", change)
+		m.refreshCurrentFeatureItemsFor(projectPath)
+		return nil
+	}
+	m.openInput(fmt.Sprintf("Really %s? (type yes to continue)", m.pendingConfirmTitle), "", inputCommandConfirm)
+}
+
+func (m *model) emitGenerateReviewTelemetry(action string, change generateFileChange) {
+	if m.currentProject == nil {
+		return
+	}
+	m.emitTelemetry("generate_file_reviewed", map[string]string{
+		"path":   filepath.Clean(m.currentProject.Path),
+		"file":   change.Path,
+		"target": change.TargetKey,
+		"status": change.Status,
+		"source": change.DiffSource,
+		"action": action,
+	})
+}
+
+func (m *model) handleDatabaseItemSelection(item featureItemDefinition) {
+	m.currentDBSchemaPath = ""
+	m.currentDBSeedPath = ""
+	if m.currentProject == nil {
+		return
+	}
+	info := gatherDatabaseDumpInfo(m.currentProject.Path)
+	if info.Found {
+		for _, file := range info.Files {
+			switch file.Kind {
+			case "schema":
+				m.currentDBSchemaPath = file.Path
+			case "seed":
+				m.currentDBSeedPath = file.Path
+			}
+		}
+	}
+	if item.Meta != nil {
+		if m.currentDBSchemaPath == "" {
+			if rel := strings.TrimSpace(item.Meta["dbSchemaRel"]); rel != "" {
+				m.currentDBSchemaPath = filepath.Join(m.currentProject.Path, filepath.FromSlash(rel))
+			}
+		}
 		if m.currentDBSeedPath == "" {
 			if rel := strings.TrimSpace(item.Meta["dbSeedRel"]); rel != "" {
 				m.currentDBSeedPath = filepath.Join(m.currentProject.Path, filepath.FromSlash(rel))
@@ -6037,6 +8383,11 @@ func (m *model) recordGenerateDiffTelemetry(item featureItemDefinition) {
 }
 
 func (m *model) runServiceCommand(itemKey string) tea.Cmd {
+	if m.servicesComposeMissing {
+		m.appendLog(composeMissingMessage)
+		m.setToast(composeMissingMessage, 5*time.Second)
+		return nil
+	}
 	defs := featureItemsForKey("services")
 	for _, def := range defs {
 		if def.Key != itemKey {
@@ -6089,15 +8440,7 @@ func (m *model) openSelectedServiceEndpoint(index int) {
 			chosen = endpoints[0]
 		}
 	}
-	url := strings.TrimSpace(chosen.URL)
-	if url == "" && strings.TrimSpace(chosen.Port) != "" {
-		host := sanitizeHost(chosen.Host)
-		path := chosen.Path
-		if path == "" {
-			path = "/"
-		}
-		url = fmt.Sprintf("http://%s:%s%s", host, chosen.Port, path)
-	}
+	url := endpointURL(chosen)
 	if url == "" {
 		m.appendLog("No valid endpoint URL for this service.")
 		m.setToast("Endpoint unavailable", 4*time.Second)
@@ -6127,12 +8470,99 @@ func (m *model) openSelectedServiceEndpoint(index int) {
 	m.setToast("Opening endpoint", 3*time.Second)
 }
 
+// endpointOpenStagger spaces out browser launches when opening every endpoint.
+const endpointOpenStagger = 400 * time.Millisecond
+
+type endpointOpenMsg struct {
+	url     string
+	service string
+}
+
+func endpointURL(ep serviceEndpoint) string {
+	url := strings.TrimSpace(ep.URL)
+	if url == "" && strings.TrimSpace(ep.Port) != "" {
+		path := ep.Path
+		if path == "" {
+			path = "/"
+		}
+		url = fmt.Sprintf("http://%s:%s%s", sanitizeHost(ep.Host), ep.Port, path)
+	}
+	return url
+}
+
+// openAllServiceEndpoints opens every healthy endpoint of every service,
+// launching them endpointOpenStagger apart.
+func (m *model) openAllServiceEndpoints() tea.Cmd {
+	if m.currentFeature != "services" || m.servicesCol == nil {
+		return nil
+	}
+	if m.currentProject == nil {
+		m.appendLog("Select a project before opening endpoints.")
+		m.setToast("Select a project first", 4*time.Second)
+		return nil
+	}
+	seen := make(map[string]bool)
+	var cmds []tea.Cmd
+	for _, item := range m.servicesCol.items {
+		if item.Meta == nil || item.Meta["serviceRow"] != "1" {
+			continue
+		}
+		service := strings.TrimSpace(item.Meta["service"])
+		for _, ep := range decodeServiceEndpoints(item.Meta["endpoints"]) {
+			url := endpointURL(ep)
+			if !ep.Healthy || url == "" || seen[url] {
+				continue
+			}
+			seen[url] = true
+			msg := endpointOpenMsg{url: url, service: service}
+			cmds = append(cmds, tea.Tick(time.Duration(len(cmds))*endpointOpenStagger, func(time.Time) tea.Msg {
+				return msg
+			}))
+		}
+	}
+	if len(cmds) == 0 {
+		m.appendLog("No healthy endpoints to open.")
+		m.setToast("No healthy endpoints", 4*time.Second)
+		return nil
+	}
+	m.emitTelemetry("endpoints_opened_all", map[string]string{
+		"project": filepath.Clean(m.currentProject.Path),
+		"feature": "services",
+		"count":   strconv.Itoa(len(cmds)),
+	})
+	m.setToast(fmt.Sprintf("Opening %d endpoint(s)", len(cmds)), 3*time.Second)
+	return tea.Batch(cmds...)
+}
+
+func (m *model) handleEndpointOpen(msg endpointOpenMsg) {
+	commandLine, err := launchBrowser(msg.url)
+	if err != nil {
+		m.appendLog(fmt.Sprintf("Failed to open endpoint %s: %v", msg.url, err))
+		return
+	}
+	if msg.service != "" {
+		m.appendLog(fmt.Sprintf("Opening endpoint (%s): %s", msg.service, msg.url))
+	} else {
+		m.appendLog("Opening endpoint: " + msg.url)
+	}
+	m.appendLog("Browser command: " + commandLine)
+}
+
+func (m *model) servicesPollInterval() time.Duration {
+	return time.Duration(m.settingsServicesPoll) * time.Second
+}
+
 func (m *model) startServicePolling() tea.Cmd {
 	if m.servicesPolling && m.servicesTimerActive {
 		return nil
 	}
+	if m.settingsServicesPoll <= 0 {
+		m.servicesPolling = false
+		m.servicesTimerActive = false
+		return nil
+	}
 	m.servicesPolling = true
-	m.servicesTimer = timer.NewWithInterval(servicesPollInterval, time.Second)
+	m.servicesTimer = timer.NewWithInterval(m.servicesPollInterval(), time.Second)
 	m.servicesTimerActive = true
 	return m.servicesTimer.Init()
 }
@@ -6142,6 +8572,65 @@ func (m *model) stopServicePolling() {
 	m.servicesTimerActive = false
 }
 
+func (m *model) dashboardRefreshInterval() time.Duration {
+	return time.Duration(m.settingsDashboardRefresh) * time.Second
+}
+
+// startDashboardRefresh arms the periodic reload of the tokens and reports
+// views, which otherwise only refresh on entry or after jobs.
+func (m *model) startDashboardRefresh() tea.Cmd {
+	if m.settingsDashboardRefresh <= 0 || (m.currentFeature != "tokens" && m.currentFeature != "reports") {
+		m.dashboardTimerActive = false
+		return nil
+	}
+	m.dashboardTimer = timer.NewWithInterval(m.dashboardRefreshInterval(), time.Second)
+	m.dashboardTimerActive = true
+	return m.dashboardTimer.Init()
+}
+
+func (m *model) stopDashboardRefresh() {
+	m.dashboardTimerActive = false
+}
+
+// reloadDashboardCmd reloads the active dashboard, keeping the selected row.
+func (m *model) reloadDashboardCmd() tea.Cmd {
+	switch m.currentFeature {
+	case "tokens":
+		if m.tokensLoading {
+			return nil
+		}
+		load := m.loadTokensUsageCmd()
+		if load == nil {
+			return nil
+		}
+		return func() tea.Msg {
+			msg, ok := load().(tokensLoadedMsg)
+			if !ok {
+				return nil
+			}
+			msg.refresh = true
+			return msg
+		}
+	case "reports":
+		if m.reportsLoading {
+			return nil
+		}
+		load := m.loadReportsEntriesCmd()
+		if load == nil {
+			return nil
+		}
+		return func() tea.Msg {
+			msg, ok := load().(reportsLoadedMsg)
+			if !ok {
+				return nil
+			}
+			msg.refresh = true
+			return msg
+		}
+	}
+	return nil
+}
+
 func (m *model) loadServicesCmd() tea.Cmd {
 	if m.currentProject == nil {
 		return nil
@@ -6149,15 +8638,20 @@ func (m *model) loadServicesCmd() tea.Cmd {
 	projectCopy := *m.currentProject
 	dockerAvailable := m.dockerAvailable
 	return func() tea.Msg {
+		composePath := findComposeFile(projectCopy.Path)
 		items := featureItemEntries(&projectCopy, "services", dockerAvailable)
-		return servicesLoadedMsg{items: items}
+		items = annotateComposeItems(items, composePath)
+		return servicesLoadedMsg{items: items, composePath: composePath}
 	}
 }
 
-func (m *model) handleServicesLoaded(items []featureItemDefinition) {
+func (m *model) handleServicesLoaded(msg servicesLoadedMsg) {
 	if m.currentFeature != "services" {
 		return
 	}
+	items := msg.items
+	m.servicesComposePath = msg.composePath
+	m.servicesComposeMissing = msg.composePath == ""
 	prevKey := m.currentItem.Key
 	if prevKey == "" {
 		if item, ok := m.servicesCol.SelectedItem(); ok {
@@ -6167,12 +8661,18 @@ func (m *model) handleServicesLoaded(items []featureItemDefinition) {
 	m.servicesCol.SetItems(items)
 	if prevKey != "" {
 		m.servicesCol.SelectKey(prevKey)
+	} else {
+		m.restoreColumnCursor(m.servicesCol)
 	}
 	if item, ok := m.servicesCol.SelectedItem(); ok {
 		m.applyItemSelection(m.currentProject, "services", item, false)
 	} else {
 		if len(items) == 0 {
-			m.previewCol.SetContent("No services detected.\n")
+			if m.settingsServicesPoll <= 0 {
+				m.previewCol.SetContent("No services detected.\nAuto-polling is off; press r to refresh.\n")
+			} else {
+				m.previewCol.SetContent("No services detected.\n")
+			}
 		}
 		m.currentItem = featureItemDefinition{}
 		m.itemsActivated = false
@@ -6255,14 +8755,17 @@ func (m *model) handleDocsPreviewEnter() (bool, tea.Cmd) {
 }
 
 func (m *model) startAttachRFP() tea.Cmd {
+	if m.rejectInReadOnly() {
+		return nil
+	}
 	if m.currentProject == nil {
 		m.appendLog("Select a project before attaching artifacts.")
 		m.setToast("Select a project first", 5*time.Second)
 		return nil
 	}
-	cmd := m.openPathPicker("Attach RFP file", "", inputAttachRFP, false, true)
-	m.inputField.Placeholder = "~/path/to/rfp.md"
-	m.appendLog("Attach RFP: Pick or enter a file to copy into .gpt-creator/staging/inputs/.")
+	cmd := m.openPathPicker("Attach RFP file or folder", "", inputAttachRFP, true, true)
+	m.inputField.Placeholder = "~/path/to/rfp.md, ~/path/to/notes.pdf"
+	m.appendLog("Attach RFP: Pick a file or folder, or enter comma-separated paths, to copy into .gpt-creator/staging/inputs/.")
 	m.setToast("Choose an RFP file", 5*time.Second)
 	return cmd
 }
@@ -6279,19 +8782,89 @@ func (m *model) handleAttachRFPSubmit(raw string) bool {
 		m.setToast("Select a project first", 5*time.Second)
 		return false
 	}
-	src := m.resolvePath(trimmed)
-	destRel, err := m.attachFileToInputs(src)
+	sources, err := m.attachSources(trimmed)
 	if err != nil {
 		m.appendLog(fmt.Sprintf("Failed to attach RFP: %v", err))
 		m.setToast("Attach RFP failed", 6*time.Second)
 		return true
 	}
-	m.appendLog(fmt.Sprintf("Attached RFP → %s", destRel))
-	m.setToast("RFP attached to staging/inputs/", 5*time.Second)
+	attached := 0
+	for _, src := range sources {
+		destRel, err := m.attachFileToInputs(src)
+		if err != nil {
+			m.appendLog(fmt.Sprintf("Failed to attach %s: %v", abbreviatePath(src), err))
+			continue
+		}
+		attached++
+		m.appendLog(fmt.Sprintf("Attached %s → %s", filepath.Base(src), destRel))
+	}
+	if attached == 0 {
+		m.setToast("Attach RFP failed", 6*time.Second)
+		return true
+	}
+	if attached == 1 {
+		m.setToast("RFP attached to staging/inputs/", 5*time.Second)
+	} else {
+		m.setToast(fmt.Sprintf("%d files attached to staging/inputs/", attached), 5*time.Second)
+	}
 	m.refreshCurrentFeatureItemsFor(filepath.Clean(m.currentProject.Path))
 	return false
 }
 
+// attachSources expands the entered paths into the files to attach. A
+// directory contributes its visible regular files, without recursing.
+func (m *model) attachSources(raw string) ([]string, error) {
+	var sources []string
+	for _, part := range m.splitAttachPaths(raw) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		src := m.resolvePath(part)
+		info, err := os.Stat(src)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			sources = append(sources, src)
+			continue
+		}
+		entries, err := os.ReadDir(src)
+		if err != nil {
+			return nil, err
+		}
+		count := 0
+		for _, entry := range entries {
+			if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+			sources = append(sources, filepath.Join(src, entry.Name()))
+			count++
+		}
+		if count == 0 {
+			return nil, fmt.Errorf("%s contains no files", src)
+		}
+	}
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no files to attach")
+	}
+	return sources, nil
+}
+
+// splitAttachPaths splits raw into paths: one per line when several lines
+// were pasted, otherwise on commas. Input that is itself an existing path is
+// kept whole so names containing commas can be attached.
+func (m *model) splitAttachPaths(raw string) []string {
+	raw = strings.TrimSpace(raw)
+	if strings.Contains(raw, "\n") {
+		return strings.Split(raw, "\n")
+	}
+	if _, err := os.Stat(m.resolvePath(raw)); err == nil {
+		return []string{raw}
+	}
+	return strings.Split(raw, ",")
+}
+
 func (m *model) attachFileToInputs(src string) (string, error) {
 	info, err := os.Stat(src)
 	if err != nil {
@@ -6313,6 +8886,12 @@ func (m *model) attachFileToInputs(src string) (string, error) {
 	if _, err := os.Stat(destPath); err == nil {
 		timestamp := time.Now().UTC().Format("20060102-150405")
 		destPath = filepath.Join(destDir, fmt.Sprintf("rfp-%s%s", timestamp, ext))
+		for n := 2; ; n++ {
+			if _, err := os.Stat(destPath); err != nil {
+				break
+			}
+			destPath = filepath.Join(destDir, fmt.Sprintf("rfp-%s-%d%s", timestamp, n, ext))
+		}
 	}
 	if err := copyFile(src, destPath); err != nil {
 		return "", err
@@ -6371,6 +8950,7 @@ func (m *model) refreshCurrentFeatureItemsFor(path string) {
 
 func (m *model) resetDocSelection() {
 	m.currentDocRelPath = ""
+	m.docTOCVisible = false
 	m.currentDocDiffBase = ""
 	m.currentDocType = ""
 }
@@ -6542,6 +9122,24 @@ func (m *model) toggleSelectedWorkspacePin() {
 	m.togglePinState(clean, !currentlyPinned)
 }
 
+func (m *model) copySelectedWorkspacePath() {
+	item, ok := m.selectedWorkspaceItem()
+	if !ok || item.kind != workspaceKindRoot || item.path == "" {
+		m.setToast("Select a project first", 4*time.Second)
+		return
+	}
+	path := filepath.Clean(item.path)
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if err := clipboard.WriteAll(path); err != nil {
+		m.appendLog(fmt.Sprintf("Failed to copy path: %v", err))
+		m.setToast("Clipboard unavailable", 4*time.Second)
+		return
+	}
+	m.setToast("Copied "+abbreviatePath(path), 3*time.Second)
+}
+
 func (m *model) togglePinState(path string, pinned bool) {
 	clean := filepath.Clean(path)
 	if clean == "" {
@@ -6576,10 +9174,51 @@ func (m *model) writeUIConfig() {
 		m.uiConfig = &uiConfig{}
 	}
 	m.uiConfig.Pinned = sortedPaths(m.pinnedPaths)
-	m.uiConfig.Theme = m.markdownTheme.String()
+	if !m.themeEphemeral {
+		m.uiConfig.Theme = m.markdownTheme.String()
+	}
 	m.uiConfig.Concurrency = m.settingsConcurrency
+	pollSeconds := m.settingsServicesPoll
+	m.uiConfig.ServicesPoll = &pollSeconds
+	m.uiConfig.DashboardRefresh = m.settingsDashboardRefresh
+	m.uiConfig.LogLines = m.settingsLogLines
+	m.uiConfig.LogsHeight = m.logsHeight
+	m.uiConfig.ColumnWidths = nil
+	if len(m.columnWidthAdjust) > 0 {
+		m.uiConfig.ColumnWidths = make(map[string][]int, len(m.columnWidthAdjust))
+		for kind, adjust := range m.columnWidthAdjust {
+			m.uiConfig.ColumnWidths[kind] = append([]int(nil), adjust...)
+		}
+	}
+	m.uiConfig.PreviewWrap = m.previewWrap
+	m.uiConfig.LineNumbers = m.previewLineNumbers
+	m.uiConfig.DocsRaw = m.docsRawMarkdown
+	telemetryEnabled := m.settingsTelemetry
+	m.uiConfig.Telemetry = &telemetryEnabled
+	m.uiConfig.DryRun = m.settingsDryRun
+	m.uiConfig.AutoWatch = m.settingsAutoWatch
+	m.uiConfig.AutoVerify = m.settingsAutoVerify
+	m.uiConfig.InlineImages = m.settingsInlineImages
+	m.uiConfig.DenseLists = m.settingsDenseLists
+	m.uiConfig.ColumnLayout = ""
+	if m.columnArrangement != arrangementDefault {
+		m.uiConfig.ColumnLayout = string(m.columnArrangement)
+	}
+	confirmCommands := append([]string{}, m.confirmCommands...)
+	m.uiConfig.ConfirmCommands = &confirmCommands
 	m.uiConfig.DockerPath = strings.TrimSpace(m.settingsDockerPath)
 	m.uiConfig.WorkspaceRoots = append([]string{}, m.customWorkspaceRoots...)
+	m.uiConfig.RecentFiles = m.recentFiles
+	m.uiConfig.HiddenFeatures = nil
+	for _, def := range featureDefinitions {
+		if m.hiddenFeatures[def.Key] {
+			m.uiConfig.HiddenFeatures = append(m.uiConfig.HiddenFeatures, def.Key)
+		}
+	}
+	if m.readOnly {
+		// Settings still change for the session; nothing is written.
+		return
+	}
 	if m.uiConfigPath == "" {
 		_, m.uiConfigPath = loadUIConfig()
 	}
@@ -6588,6 +9227,158 @@ func (m *model) writeUIConfig() {
 	}
 }
 
+// exportSettings writes the current UI settings to path, or to
+// gpt-creator-ui.yaml inside it when path is a directory.
+func (m *model) exportSettings(raw string) bool {
+	if m.rejectInReadOnly() {
+		return false
+	}
+	target := m.resolvePath(strings.TrimSpace(raw))
+	if target == "" {
+		m.setToast("Choose a directory or file", 4*time.Second)
+		return false
+	}
+	if dirExists(target) {
+		target = filepath.Join(target, settingsExportName)
+	}
+	m.writeUIConfig()
+	if err := saveUIConfig(m.uiConfig, target); err != nil {
+		m.appendLog(fmt.Sprintf("Failed to export settings: %v", err))
+		m.setToast("Export failed", 4*time.Second)
+		return false
+	}
+	m.appendLog("Settings exported to " + target)
+	m.emitTelemetry("settings_exported", map[string]string{"path": target})
+	m.setToast("Settings exported to "+abbreviatePath(target), 4*time.Second)
+	return true
+}
+
+// importSettings applies a settings file exported by exportSettings. Pins,
+// roots and the docker path are only kept when they exist here; imported
+// pins and workspace roots are added to the ones already configured.
+func (m *model) importSettings(raw string) (tea.Cmd, bool) {
+	if m.rejectInReadOnly() {
+		return nil, false
+	}
+	source := m.resolvePath(strings.TrimSpace(raw))
+	cfg, err := readUIConfigFile(source)
+	if err != nil {
+		m.appendLog(fmt.Sprintf("Failed to import settings: %v", err))
+		m.setToast("Import failed", 4*time.Second)
+		return nil, false
+	}
+	for _, path := range cfg.dropMissingPaths() {
+		m.appendLog("Skipped missing path from imported settings: " + path)
+	}
+	cmd := m.applyImportedUIConfig(cfg)
+	m.appendLog("Settings imported from " + source)
+	m.emitTelemetry("settings_imported", map[string]string{"path": source})
+	m.setToast("Settings imported", 4*time.Second)
+	return cmd, true
+}
+
+func (m *model) applyImportedUIConfig(cfg *uiConfig) tea.Cmd {
+	if m.pinnedPaths == nil {
+		m.pinnedPaths = make(map[string]bool, len(cfg.Pinned))
+	}
+	for _, path := range cfg.Pinned {
+		m.pinnedPaths[path] = true
+	}
+	if theme := strings.TrimSpace(cfg.Theme); theme != "" {
+		m.applyMarkdownTheme(markdownThemeFromString(theme), false)
+	}
+	var cmd tea.Cmd
+	if cfg.Concurrency > 0 && cfg.Concurrency != m.settingsConcurrency {
+		m.settingsConcurrency = cfg.Concurrency
+		if m.jobRunner != nil {
+			cmd = m.jobRunner.SetMaxParallel(cfg.Concurrency)
+		}
+	}
+	if cfg.ServicesPoll != nil && *cfg.ServicesPoll >= 0 {
+		m.settingsServicesPoll = min(*cfg.ServicesPoll, maxServicesPollSeconds)
+	}
+	m.settingsDashboardRefresh = min(max(cfg.DashboardRefresh, 0), maxDashboardRefreshSeconds)
+	if cfg.LogLines > 0 {
+		m.settingsLogLines = min(max(cfg.LogLines, minLogLines), maxLogLines)
+		m.trimLogLines()
+	}
+	if cfg.LogsHeight > 0 {
+		m.logsHeight = min(max(cfg.LogsHeight, minLogsColumnHeight), maxLogsColumnHeight)
+	}
+	m.columnWidthAdjust = make(map[string][]int, len(cfg.ColumnWidths))
+	for kind, adjust := range cfg.ColumnWidths {
+		clamped := make([]int, len(adjust))
+		for i, delta := range adjust {
+			clamped[i] = min(max(delta, -maxColumnWidthAdjust), maxColumnWidthAdjust)
+		}
+		m.columnWidthAdjust[kind] = clamped
+	}
+	m.previewWrap = cfg.PreviewWrap
+	if m.previewCol != nil {
+		m.previewCol.SetWrap(m.previewWrap)
+	}
+	m.previewLineNumbers = cfg.LineNumbers
+	m.docsRawMarkdown = cfg.DocsRaw
+	if cfg.Telemetry != nil {
+		m.settingsTelemetry = *cfg.Telemetry
+		m.telemetry.SetEnabled(m.settingsTelemetry)
+	}
+	m.settingsDryRun = cfg.DryRun
+	m.settingsAutoWatch = cfg.AutoWatch
+	m.settingsAutoVerify = cfg.AutoVerify
+	m.settingsInlineImages = cfg.InlineImages
+	m.settingsDenseLists = cfg.DenseLists
+	m.applyDenseLists()
+	m.hiddenFeatures = hiddenFeatureSet(cfg.HiddenFeatures)
+	m.populateFeatureList()
+	m.columnArrangement = columnArrangementFromString(cfg.ColumnLayout)
+	if cfg.ConfirmCommands != nil {
+		m.confirmCommands = parseConfirmCommands(strings.Join(*cfg.ConfirmCommands, ","))
+	}
+	m.settingsDockerPath = strings.TrimSpace(cfg.DockerPath)
+	m.dockerAvailable = dockerCLIAvailableWithPath(m.settingsDockerPath)
+	m.dockerUnavailableReason = ""
+	known := make(map[string]bool, len(m.customWorkspaceRoots))
+	for _, root := range m.customWorkspaceRoots {
+		known[filepath.Clean(root)] = true
+	}
+	for _, root := range cfg.WorkspaceRoots {
+		if known[root] {
+			continue
+		}
+		known[root] = true
+		m.customWorkspaceRoots = append(m.customWorkspaceRoots, root)
+		if !m.hasWorkspaceRoot(root) {
+			m.workspaceRoots = append(m.workspaceRoots, workspaceRoot{Label: labelForPath(root), Path: root})
+		}
+		if m.workspaceStore != nil {
+			if err := m.workspaceStore.Add(root); err != nil {
+				m.appendLog(fmt.Sprintf("Failed to persist workspace root: %v", err))
+			}
+		}
+	}
+	sort.Strings(m.customWorkspaceRoots)
+	if m.uiConfig == nil {
+		m.uiConfig = &uiConfig{}
+	}
+	m.uiConfig.TelemetryMaxMB = cfg.TelemetryMaxMB
+	if cfg.TelemetryMaxMB > 0 {
+		m.telemetry.SetMaxBytes(int64(cfg.TelemetryMaxMB) << 20)
+	}
+	m.uiConfig.ModelContext = cfg.ModelContext
+	m.writeUIConfig()
+
+	m.ensurePinnedRoots()
+	m.syncRootWatcher()
+	m.syncArtifactWatcher()
+	m.refreshWorkspaceColumn()
+	m.refreshLogs()
+	m.applyLayout()
+	m.clampFocusAfterLayout()
+	m.refreshSettingsItems()
+	return cmd
+}
+
 func (m *model) handleNewProjectPathSubmit(raw string) (tea.Cmd, bool) {
 	resolved := m.resolvePath(strings.TrimSpace(raw))
 	if resolved == "" {
@@ -6614,12 +9405,8 @@ func (m *model) handleNewProjectPathSubmit(raw string) (tea.Cmd, bool) {
 		m.openInput(prompt+" (type YES to continue)", "", inputNewProjectConfirm)
 		return nil, true
 	}
-	cmd, keep := m.finalizeNewProject(resolved)
-	if !keep {
-		m.pendingNewProjectPath = ""
-		m.pendingNewProjectTemplate = ""
-	}
-	return cmd, keep
+	m.openTemplatePrompt()
+	return nil, true
 }
 
 func (m *model) finalizeNewProject(path string) (tea.Cmd, bool) {
@@ -6667,10 +9454,14 @@ func (m *model) validateNewProjectPath(path string) (bool, string, error) {
 	if err := checkDirWritable(parent); err != nil {
 		return false, "", err
 	}
+	var reasons []string
+	if collision := m.projectCollision(clean); collision != "" {
+		reasons = append(reasons, collision)
+	}
 	info, err = os.Stat(clean)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
-			return false, "", nil
+			return len(reasons) > 0, strings.Join(reasons, " "), nil
 		}
 		return false, "", err
 	}
@@ -6682,9 +9473,51 @@ func (m *model) validateNewProjectPath(path string) (bool, string, error) {
 		return false, "", err
 	}
 	if !empty {
-		return true, "Directory not empty.", nil
+		reasons = append(reasons, "Directory not empty.")
+	}
+	return len(reasons) > 0, strings.Join(reasons, " "), nil
+}
+
+// projectCollision describes an existing project that path equals or sits
+// inside, so new projects are not nested by accident. Only workspace entries
+// that are real gpt-creator projects count.
+func (m *model) projectCollision(path string) string {
+	candidates := make(map[string]string)
+	for _, root := range m.workspaceRoots {
+		candidates[filepath.Clean(root.Path)] = root.Label
+	}
+	for pinned := range m.pinnedPaths {
+		if _, ok := candidates[filepath.Clean(pinned)]; !ok {
+			candidates[filepath.Clean(pinned)] = labelForPath(pinned)
+		}
+	}
+	for _, project := range m.projects {
+		candidates[filepath.Clean(project.Path)] = project.Name
+	}
+	best := ""
+	for root := range candidates {
+		rel, err := filepath.Rel(root, path)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		if !isProjectDir(root) {
+			continue
+		}
+		if len(root) > len(best) {
+			best = root
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	name := strings.TrimSpace(candidates[best])
+	if name == "" {
+		name = filepath.Base(best)
 	}
-	return false, "", nil
+	if best == path {
+		return fmt.Sprintf("Path is the existing project %q.", name)
+	}
+	return fmt.Sprintf("Path is inside existing project %q (%s).", name, abbreviatePath(best))
 }
 
 func (m *model) appendLog(line string) {
@@ -6693,16 +9526,58 @@ func (m *model) appendLog(line string) {
 	}
 	decorated := m.decorateLogLine(line)
 	m.logLines = append(m.logLines, decorated)
-	if len(m.logLines) > 400 {
-		m.logLines = m.logLines[len(m.logLines)-400:]
+	if m.logsFindQuery != "" && logLineMatches(decorated, m.logsFindQuery) {
+		m.logsFindMatches = append(m.logsFindMatches, len(m.logLines)-1)
 	}
+	m.trimLogLines()
 	m.refreshLogs()
 	if m.logsSelectionActive {
 		m.ensureLogCursorVisible()
 	}
 }
 
-func (m *model) appendDebugLog(format string, args ...interface{}) {
+// trimLogLines drops the oldest lines beyond the configured history cap and
+// shifts the indexes that point into the log.
+func (m *model) trimLogLines() {
+	limit := m.settingsLogLines
+	if limit <= 0 {
+		limit = defaultLogLines
+	}
+	if len(m.logLines) <= limit {
+		return
+	}
+	dropped := len(m.logLines) - limit
+	m.logLines = m.logLines[dropped:]
+	if m.lastFailureLogIndex >= 0 {
+		m.lastFailureLogIndex -= dropped
+		if m.lastFailureLogIndex < 0 {
+			m.lastFailureLogIndex = -1
+		}
+	}
+	m.shiftLogsFindMatches(dropped)
+	if m.logsSelectionActive {
+		m.logsSelectionAnchor = max(m.logsSelectionAnchor-dropped, 0)
+		m.logsSelectionCursor = max(m.logsSelectionCursor-dropped, 0)
+	}
+}
+
+// clearLogs empties the combined log while keeping the job queue header. It
+// only resets the panel; log files on disk and session commands are kept.
+func (m *model) clearLogs() {
+	m.logLines = nil
+	m.lastFailureLogIndex = -1
+	m.lastFailurePending = false
+	m.logsSelectionActive = false
+	m.logsSelectionAnchor = -1
+	m.logsSelectionCursor = -1
+	m.logsFindQuery = ""
+	m.logsFindMatches = nil
+	m.logsFindCurrent = -1
+	m.refreshLogs()
+	m.setToast("Logs cleared", 3*time.Second)
+}
+
+func (m *model) appendDebugLog(format string, args ...interface{}) {
 	msg := strings.TrimSpace(fmt.Sprintf(format, args...))
 	if msg == "" {
 		return
@@ -6740,14 +9615,22 @@ func (m *model) renderLogLinesWithSelection() string {
 		return ""
 	}
 	start, end, ok := m.logSelectionRange()
-	if !ok {
+	if !ok && len(m.logsFindMatches) == 0 {
 		return strings.Join(m.logLines, "\n")
 	}
+	matched := make(map[int]bool, len(m.logsFindMatches))
+	for _, index := range m.logsFindMatches {
+		matched[index] = true
+	}
+	current := m.currentLogsFindLine()
 	var b strings.Builder
 	for i, line := range m.logLines {
-		if i >= start && i <= end {
-			b.WriteString(m.styles.logSelection.Render(line))
-		} else {
+		switch {
+		case ok && i >= start && i <= end, i == current:
+			b.WriteString(m.styles.logSelection.Render(stripANSI(line)))
+		case matched[i]:
+			b.WriteString(m.styles.logMatch.Render(stripANSI(line)))
+		default:
 			b.WriteString(line)
 		}
 		if i < len(m.logLines)-1 {
@@ -6808,6 +9691,105 @@ func (m *model) toggleLogsSelection() {
 	m.setToast("Log selection enabled • use ↑/↓ or Shift+↑/↓ to adjust", 4*time.Second)
 }
 
+func (m *model) togglePreviewWrap() {
+	m.previewWrap = !m.previewWrap
+	if m.previewCol != nil {
+		m.previewCol.SetWrap(m.previewWrap)
+	}
+	m.writeUIConfig()
+	if m.previewWrap {
+		m.setToast("Preview wrap on", 2*time.Second)
+	} else {
+		m.setToast("Preview wrap off • H/L to scroll", 2*time.Second)
+	}
+}
+
+// togglePreviewLineNumbers switches the gutter on artifact file previews and
+// side-by-side diffs.
+func (m *model) togglePreviewLineNumbers() {
+	m.previewLineNumbers = !m.previewLineNumbers
+	m.writeUIConfig()
+	if m.currentFeature == "artifacts" {
+		if node := m.currentArtifactNode(); node != nil {
+			content := ""
+			if m.artifactSplit.Enabled {
+				content, _ = m.refreshArtifactSplit(*node)
+			}
+			if content == "" {
+				content = m.renderArtifactPreview(*node)
+			}
+			m.previewCol.SetContent(content)
+		}
+	}
+	if m.previewLineNumbers {
+		m.setToast("Line numbers on", 2*time.Second)
+	} else {
+		m.setToast("Line numbers off", 2*time.Second)
+	}
+}
+
+func (m *model) resizeLogsPanel(delta int) {
+	if !m.showLogs {
+		m.setToast("Logs are hidden • press F6 to show them", 3*time.Second)
+		return
+	}
+	height := min(max(m.logsHeight+delta, minLogsColumnHeight), maxLogsColumnHeight)
+	if height == m.logsHeight {
+		m.setToast(fmt.Sprintf("Log panel height limited to %d–%d lines", minLogsColumnHeight, maxLogsColumnHeight), 3*time.Second)
+		return
+	}
+	m.logsHeight = height
+	m.applyLayout()
+	m.clampFocusAfterLayout()
+	m.refreshLogs()
+	m.ensureLogCursorVisible()
+	m.writeUIConfig()
+	m.setToast(fmt.Sprintf("Log panel height: %d", height), 2*time.Second)
+}
+
+const (
+	columnWidthStep      = 4
+	maxColumnWidthAdjust = 60
+)
+
+func (m *model) resizeFocusedColumn(delta int) {
+	if m.logsFocused || m.focus < 0 || m.focus >= len(m.columns) {
+		m.setToast("Focus a column to resize it", 3*time.Second)
+		return
+	}
+	kind := m.layoutKind()
+	adjust := append([]int(nil), m.columnWidthAdjust[kind]...)
+	for len(adjust) <= m.focus {
+		adjust = append(adjust, 0)
+	}
+	next := min(max(adjust[m.focus]+delta, -maxColumnWidthAdjust), maxColumnWidthAdjust)
+	if next == adjust[m.focus] {
+		m.setToast(fmt.Sprintf("Column width adjustment limited to ±%d", maxColumnWidthAdjust), 3*time.Second)
+		return
+	}
+	adjust[m.focus] = next
+	m.columnWidthAdjust[kind] = adjust
+	m.applyLayout()
+	m.writeUIConfig()
+	m.setToast(fmt.Sprintf("%s width: %d", m.columns[m.focus].Title(), m.columnWidths[m.focus]), 2*time.Second)
+}
+
+func (m *model) revealLastFailure() {
+	index := m.lastFailureLogIndex
+	if !m.lastFailurePending || index < 0 || index >= len(m.logLines) {
+		m.setToast("No recent job failure to reveal", 3*time.Second)
+		return
+	}
+	if !m.showLogs {
+		m.showLogs = true
+		m.refreshLogs()
+		m.applyLayout()
+		m.clampFocusAfterLayout()
+	}
+	m.focusLogsPanel()
+	m.moveLogSelectionTo(index, false)
+}
+
 func (m *model) ensureLogsSelectionInitialized() {
 	if !m.showLogs || len(m.logLines) == 0 {
 		return
@@ -6995,11 +9977,208 @@ func (m *model) copyLogSelection() {
 	m.setToast(msg, 4*time.Second)
 }
 
+func (m *model) copyPreviewContent() {
+	if m.previewCol == nil {
+		return
+	}
+	text := m.previewCol.PlainText()
+	if strings.TrimSpace(text) == "" {
+		m.setToast("Preview is empty", 3*time.Second)
+		return
+	}
+	if err := clipboard.WriteAll(text); err != nil {
+		m.appendLog(fmt.Sprintf("Failed to copy preview: %v", err))
+		m.setToast("Clipboard unavailable", 4*time.Second)
+		return
+	}
+	lines := strings.Count(text, "\n") + 1
+	m.setToast(fmt.Sprintf("Copied %d preview line(s) to clipboard", lines), 4*time.Second)
+}
+
+func (m *model) recordSessionCommand(dir string, args []string) {
+	m.sessionCommands = append(m.sessionCommands, sessionCommand{
+		dir:  dir,
+		args: append([]string{}, args...),
+	})
+}
+
+// copySessionCommands copies every gpt-creator command queued this session
+// as a replayable shell script, changing directory whenever the project does.
+func (m *model) copySessionCommands() {
+	if len(m.sessionCommands) == 0 {
+		m.setToast("No commands queued this session", 3*time.Second)
+		return
+	}
+	var b strings.Builder
+	b.WriteString("#!/usr/bin/env bash\n")
+	b.WriteString(fmt.Sprintf("# gpt-creator session commands (%d)\n", len(m.sessionCommands)))
+	b.WriteString("set -e\n")
+	lastDir := ""
+	for _, cmd := range m.sessionCommands {
+		if cmd.dir != "" && cmd.dir != lastDir {
+			b.WriteString("\ncd " + shellQuote(cmd.dir) + "\n")
+			lastDir = cmd.dir
+		}
+		parts := []string{"gpt-creator"}
+		for _, arg := range cmd.args {
+			parts = append(parts, shellQuote(arg))
+		}
+		b.WriteString(strings.Join(parts, " ") + "\n")
+	}
+	if err := clipboard.WriteAll(b.String()); err != nil {
+		m.appendLog(fmt.Sprintf("Failed to copy session commands: %v", err))
+		m.setToast("Clipboard unavailable", 4*time.Second)
+		return
+	}
+	m.setToast(fmt.Sprintf("Copied %d session command(s) to clipboard", len(m.sessionCommands)), 4*time.Second)
+}
+
+// showDiagnostics renders environment details in the preview for bug
+// reports. It only reads state; the CLI version arrives asynchronously.
+func (m *model) showDiagnostics() tea.Cmd {
+	if m.previewCol == nil {
+		return nil
+	}
+	m.diagnosticsActive = true
+	m.diagnosticsVersion = "checking…"
+	m.previewCol.SetContent(m.diagnosticsPreview())
+	m.setFocusArea(focusPreview)
+	m.emitTelemetry("diagnostics_viewed", map[string]string{})
+	return fetchCLIVersion()
+}
+
+// startDockerCheck probes the docker daemon and compose plugin and shows the
+// result in the preview.
+func (m *model) startDockerCheck() tea.Cmd {
+	if m.previewCol == nil {
+		return nil
+	}
+	m.dockerCheckActive = true
+	m.previewCol.SetContent("Docker readiness\n────────────────\n\nRunning docker info and docker compose version…\n")
+	m.setFocusArea(focusPreview)
+	return checkDockerReadiness(m.settingsDockerPath)
+}
+
+func (m *model) handleDockerReadiness(r dockerReadiness) {
+	wasAvailable := m.dockerAvailable
+	m.dockerAvailable = r.ready()
+	m.dockerUnavailableReason = r.reason()
+	fields := map[string]string{"ready": strconv.FormatBool(r.ready())}
+	if reason := r.reason(); reason != "" {
+		fields["reason"] = reason
+		m.appendLog("Docker check: " + reason)
+		m.setToast(reason, 5*time.Second)
+	} else {
+		m.appendLog(fmt.Sprintf("Docker check: server %s, compose %s", r.serverVersion, r.composeVersion))
+		m.setToast("Docker is ready", 3*time.Second)
+	}
+	m.emitTelemetry("docker_checked", fields)
+	if m.dockerCheckActive && m.previewCol != nil {
+		m.previewCol.SetContent(renderDockerReadiness(r))
+	}
+	if wasAvailable != m.dockerAvailable && m.currentProject != nil {
+		m.refreshCurrentFeatureItemsFor(m.currentProject.Path)
+	}
+	if m.currentFeature == "settings" {
+		m.refreshSettingsItems()
+	}
+}
+
+// dockerUnavailableMessage names why docker-backed commands are blocked,
+// using the last readiness check when there was one.
+func (m *model) dockerUnavailableMessage() string {
+	return fallback(m.dockerUnavailableReason, "Docker CLI not available")
+}
+
+func (m *model) handleCLIVersion(msg cliVersionMsg) {
+	switch {
+	case msg.err != nil && msg.version != "":
+		m.diagnosticsVersion = fmt.Sprintf("%s (%v)", msg.version, msg.err)
+	case msg.err != nil:
+		m.diagnosticsVersion = fmt.Sprintf("unavailable (%v)", msg.err)
+	default:
+		m.diagnosticsVersion = msg.version
+	}
+	if m.diagnosticsActive && m.previewCol != nil {
+		m.previewCol.SetContent(m.diagnosticsPreview())
+	}
+}
+
+func (m *model) handleCLICommandsDiscovered(msg cliCommandsDiscoveredMsg) {
+	if msg.err != nil && msg.commands == nil {
+		m.appendLog(fmt.Sprintf("Command discovery failed: %v", msg.err))
+		m.setToast("Command discovery failed", 5*time.Second)
+		return
+	}
+	if msg.err != nil {
+		m.appendLog(fmt.Sprintf("Failed to cache discovered commands: %v", msg.err))
+	}
+	known := make(map[string]bool)
+	for _, defs := range featureItemsByKey {
+		for _, def := range defs {
+			known[strings.Join(def.Command, " ")] = true
+		}
+	}
+	added := 0
+	for _, cmd := range msg.commands {
+		if !known[strings.Join(cmd.Command, " ")] {
+			added++
+		}
+	}
+	m.discoveredCommands = msg.commands
+	m.refreshCommandCatalog()
+	m.appendLog(fmt.Sprintf("Discovered %d gpt-creator commands; %d added to the palette.", len(msg.commands), added))
+	m.setToast(fmt.Sprintf("%d new palette commands", added), 4*time.Second)
+}
+
+func (m *model) diagnosticsInfo() diagnosticsInfo {
+	dockerPath := resolveDockerBinary(m.settingsDockerPath)
+	info := diagnosticsInfo{
+		cliVersion:      m.diagnosticsVersion,
+		dockerPath:      dockerPath,
+		dockerAvailable: dockerCLIAvailableWithPath(dockerPath),
+		configDir:       resolveConfigDir(),
+		stateDir:        resolveStateDir(),
+		uiConfigPath:    m.uiConfigPath,
+		telemetryPath:   telemetryLogPath(),
+		projectCount:    len(m.projects),
+	}
+	if m.currentRoot != nil {
+		info.workspaceRoot = m.currentRoot.Path
+	}
+	if m.currentProject != nil {
+		info.currentProject = m.currentProject.Path
+	}
+	return info
+}
+
+func (m *model) diagnosticsPreview() string {
+	return renderDiagnostics(m.diagnosticsInfo()) + "\ny copy diagnostics\n"
+}
+
+func (m *model) copyDiagnostics() {
+	if err := clipboard.WriteAll(renderDiagnostics(m.diagnosticsInfo())); err != nil {
+		m.appendLog(fmt.Sprintf("Failed to copy diagnostics: %v", err))
+		m.setToast("Clipboard unavailable", 4*time.Second)
+		return
+	}
+	m.setToast("Diagnostics copied to clipboard", 3*time.Second)
+}
+
+func shellQuote(arg string) string {
+	if arg != "" && strings.IndexFunc(arg, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./=:@+,", r))
+	}) < 0 {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
 func (m *model) refreshLogs() {
 	content := m.renderLogsViewportContent()
 	prevOffset := m.logs.YOffset
 	m.logs.SetContent(content)
-	if m.logsSelectionActive {
+	if m.logsSelectionActive || m.logsFindQuery != "" {
 		maxOffset := 0
 		if total := len(m.logLines) - m.logs.Height; total > 0 {
 			maxOffset = total
@@ -7031,6 +10210,9 @@ func (m *model) handleLogsKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 		m.copyLogSelection()
 		return true, nil
 	}
+	if handled := m.handleLogsFindKey(msg); handled {
+		return true, nil
+	}
 	if m.logsSelectionActive && m.handleLogsSelectionNav(msg) {
 		return true, nil
 	}
@@ -7055,10 +10237,126 @@ func (m *model) handleLogsKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 	case key.Matches(msg, m.keys.logsBottom):
 		m.logs.GotoBottom()
 		return true, nil
+	case msg.String() == "esc":
+		m.returnFocusFromLogs()
+		return true, nil
 	}
 	return false, nil
 }
 
+// handleLogsFindKey searches the combined log while it is focused: "/"
+// prompts for a query, n/N cycle matching lines and esc clears the search.
+func (m *model) handleLogsFindKey(msg tea.KeyMsg) bool {
+	switch msg.String() {
+	case "/":
+		m.openInput("Find in logs", m.logsFindQuery, inputLogsFind)
+		return true
+	case "n", "N":
+		if m.logsFindQuery == "" {
+			return false
+		}
+		m.stepLogsFind(ternary(msg.String() == "n", 1, -1))
+		return true
+	case "esc":
+		if m.logsFindQuery == "" {
+			return false
+		}
+		m.clearLogsFind()
+		return true
+	}
+	return false
+}
+
+func (m *model) applyLogsFind(query string) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		m.clearLogsFind()
+		return
+	}
+	m.logsFindQuery = query
+	m.logsFindMatches = m.logsFindMatches[:0]
+	for i, line := range m.logLines {
+		if logLineMatches(line, query) {
+			m.logsFindMatches = append(m.logsFindMatches, i)
+		}
+	}
+	if len(m.logsFindMatches) == 0 {
+		m.logsFindCurrent = -1
+		m.refreshLogs()
+		m.setToast(fmt.Sprintf("No log lines match %q", query), 3*time.Second)
+		return
+	}
+	// Start from the newest match; errors usually sit near the end.
+	m.logsFindCurrent = len(m.logsFindMatches) - 1
+	m.scrollToLogsFindMatch()
+	m.setToast(fmt.Sprintf("%d log lines match %q • n/N to cycle", len(m.logsFindMatches), query), 3*time.Second)
+}
+
+func (m *model) stepLogsFind(delta int) {
+	if len(m.logsFindMatches) == 0 {
+		m.setToast(fmt.Sprintf("No log lines match %q", m.logsFindQuery), 3*time.Second)
+		return
+	}
+	count := len(m.logsFindMatches)
+	m.logsFindCurrent = ((m.logsFindCurrent+delta)%count + count) % count
+	m.scrollToLogsFindMatch()
+}
+
+func (m *model) clearLogsFind() {
+	m.logsFindQuery = ""
+	m.logsFindMatches = nil
+	m.logsFindCurrent = -1
+	m.refreshLogs()
+}
+
+func (m *model) currentLogsFindLine() int {
+	if m.logsFindCurrent < 0 || m.logsFindCurrent >= len(m.logsFindMatches) {
+		return -1
+	}
+	return m.logsFindMatches[m.logsFindCurrent]
+}
+
+// shiftLogsFindMatches drops matches for lines trimmed off the front of the
+// log and renumbers the rest.
+func (m *model) shiftLogsFindMatches(dropped int) {
+	if len(m.logsFindMatches) == 0 {
+		return
+	}
+	current := m.currentLogsFindLine()
+	kept := m.logsFindMatches[:0]
+	for _, index := range m.logsFindMatches {
+		if index -= dropped; index >= 0 {
+			kept = append(kept, index)
+		}
+	}
+	m.logsFindMatches = kept
+	m.logsFindCurrent = -1
+	for i, index := range kept {
+		if index == current-dropped {
+			m.logsFindCurrent = i
+		}
+	}
+}
+
+// scrollToLogsFindMatch centres the current match, accounting for the job
+// queue rendered above the log lines.
+func (m *model) scrollToLogsFindMatch() {
+	line := m.currentLogsFindLine()
+	if line < 0 {
+		return
+	}
+	m.refreshLogs()
+	offset := 0
+	if queue := strings.TrimSpace(m.renderJobQueue()); queue != "" {
+		offset = lipgloss.Height(queue) + 1
+	}
+	m.logs.SetYOffset(max(offset+line-m.logs.Height/2, 0))
+}
+
+func logLineMatches(line, query string) bool {
+	return strings.Contains(strings.ToLower(stripANSI(line)), strings.ToLower(query))
+}
+
 func (m *model) handleLogsMouse(msg tea.MouseMsg) (bool, tea.Cmd) {
 	if !m.showLogs || m.logsCol == nil {
 		return false, nil
@@ -7147,7 +10445,7 @@ func (m *model) applyLayout() {
 
 	logsReserved := 0
 	if m.showLogs {
-		logsReserved = logsColumnHeight
+		logsReserved = m.logsHeight
 	}
 
 	bottomReserved := logsReserved
@@ -7171,8 +10469,8 @@ func (m *model) applyLayout() {
 		m.columnsScrollX = 0
 		m.columnsHeight = columnsAvailable
 		if m.showLogs && m.logsCol != nil {
-			m.logsCol.SetSize(logsColumnWidth, logsColumnHeight)
-			m.logsPanelHeight = logsColumnHeight
+			m.logsCol.SetSize(logsColumnWidth, m.logsHeight)
+			m.logsPanelHeight = m.logsHeight
 			top := m.columnsTop + m.columnsHeight + 1
 			if top < 0 {
 				top = 0
@@ -7194,6 +10492,8 @@ func (m *model) applyLayout() {
 		widths = []int{44, 45, 52, 32}
 	} else if m.usingReportsLayout {
 		widths = []int{44, 41, 58, 32}
+	} else if m.usingTelemetryLayout {
+		widths = []int{44, 41, 72, 32}
 	} else if m.usingTokensLayout {
 		widths = []int{44, 41, 60, 32}
 	} else if m.usingEnvLayout {
@@ -7208,6 +10508,19 @@ func (m *model) applyLayout() {
 	if len(widths) > len(m.columns) {
 		widths = widths[:len(m.columns)]
 	}
+	for i, delta := range m.columnWidthAdjust[m.layoutKind()] {
+		if i < len(widths) {
+			widths[i] += delta
+		}
+	}
+	if m.columnArrangement == arrangementPreviewWide && len(widths) > 1 {
+		widths[len(widths)-1] += previewWideExtra
+		widths[0] -= previewWideExtra / 2
+	}
+	focusTarget := -1
+	if m.focusMode {
+		focusTarget = m.focusModeColumn()
+	}
 
 	minWidths := make([]int, len(m.columns))
 	for i, col := range m.columns {
@@ -7220,6 +10533,12 @@ func (m *model) applyLayout() {
 		}
 	}
 	widths = distributeColumnWidths(widths, minWidths, availableWidth)
+	if focusTarget >= 0 {
+		for i := range widths {
+			widths[i] = 0
+		}
+		widths[focusTarget] = availableWidth
+	}
 
 	if cap(m.columnWidths) < len(m.columns) {
 		m.columnWidths = make([]int, len(m.columns))
@@ -7232,24 +10551,29 @@ func (m *model) applyLayout() {
 		m.columnOffsets = m.columnOffsets[:len(m.columns)]
 	}
 
-	total := 0
 	for i, col := range m.columns {
 		width := widths[i]
+		if focusTarget >= 0 && i != focusTarget {
+			m.columnWidths[i] = 0
+			continue
+		}
 		if width < 0 {
 			width = 0
 		}
 		col.SetSize(width, columnsAvailable)
 		m.columns[i] = col
-		actualWidth := actualColumnWidth(col, width)
+		m.columnWidths[i] = actualColumnWidth(col, width)
+	}
+	total := 0
+	for _, i := range m.columnDisplayOrder() {
 		m.columnOffsets[i] = total
-		m.columnWidths[i] = actualWidth
-		total += actualWidth
+		total += m.columnWidths[i]
 	}
 	m.columnsTotalWidth = total
 	m.adjustColumnsScroll()
 	if m.showLogs && m.logsCol != nil {
-		m.logsCol.SetSize(logsColumnWidth, logsColumnHeight)
-		m.logsPanelHeight = logsColumnHeight
+		m.logsCol.SetSize(logsColumnWidth, m.logsHeight)
+		m.logsPanelHeight = m.logsHeight
 		top := m.columnsTop + m.columnsHeight + 1
 		if top < 0 {
 			top = 0
@@ -7267,6 +10591,121 @@ func (m *model) applyLayout() {
 	}
 }
 
+// columnArrangement is the user's choice of column order and emphasis. It
+// applies to every layout, whose columns all end with the preview.
+type columnArrangement string
+
+const (
+	arrangementDefault     columnArrangement = "default"
+	arrangementPreviewLeft columnArrangement = "preview-left"
+	arrangementPreviewWide columnArrangement = "preview-wide"
+)
+
+var columnArrangements = []columnArrangement{arrangementDefault, arrangementPreviewLeft, arrangementPreviewWide}
+
+// previewWideExtra is the width the wide-preview arrangement adds to the
+// preview; half of it is taken from the first column.
+const previewWideExtra = 24
+
+func columnArrangementFromString(value string) columnArrangement {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for _, arrangement := range columnArrangements {
+		if string(arrangement) == value {
+			return arrangement
+		}
+	}
+	return arrangementDefault
+}
+
+func columnArrangementLabel(arrangement columnArrangement) string {
+	switch arrangement {
+	case arrangementPreviewLeft:
+		return "Preview left"
+	case arrangementPreviewWide:
+		return "Wide preview"
+	default:
+		return "Default"
+	}
+}
+
+// columnDisplayOrder lists column indexes in the order they are drawn. The
+// indexes themselves keep their meaning (focusWorkspace … focusPreview).
+func (m *model) columnDisplayOrder() []int {
+	if m.focusMode {
+		if idx := m.focusModeColumn(); idx >= 0 {
+			return []int{idx}
+		}
+	}
+	order := make([]int, len(m.columns))
+	for i := range order {
+		order[i] = i
+	}
+	if m.columnArrangement == arrangementPreviewLeft && len(order) > 1 {
+		last := order[len(order)-1]
+		copy(order[1:], order[:len(order)-1])
+		order[0] = last
+	}
+	return order
+}
+
+// focusModeColumn is the column kept in focus mode: the preview, or the last
+// column when the current layout has no preview.
+func (m *model) focusModeColumn() int {
+	for i, col := range m.columns {
+		if col == column(m.previewCol) {
+			return i
+		}
+	}
+	return len(m.columns) - 1
+}
+
+// toggleFocusMode hides the list columns and the logs panel so the preview
+// fills the screen; toggling again restores the previous layout.
+func (m *model) toggleFocusMode() {
+	m.focusMode = !m.focusMode
+	if m.focusMode {
+		m.focusModeLogs = m.showLogs
+		m.showLogs = false
+		m.logsFocused = false
+		if idx := m.focusModeColumn(); idx >= 0 {
+			m.setFocusIndex(idx)
+		}
+	} else {
+		m.showLogs = m.focusModeLogs
+		if m.showLogs {
+			m.refreshLogs()
+		}
+	}
+	m.applyLayout()
+	m.clampFocusAfterLayout()
+	m.setToast(ternary(m.focusMode, "Focus mode • z to restore columns", "Focus mode off"), 3*time.Second)
+}
+
+// layoutKind names the active column layout; user width adjustments are
+// stored per kind.
+func (m *model) layoutKind() string {
+	switch {
+	case m.usingTasksLayout:
+		return "tasks"
+	case m.usingServicesLayout:
+		return "services"
+	case m.usingArtifactsLayout:
+		return "artifacts"
+	case m.usingReportsLayout:
+		return "reports"
+	case m.usingTelemetryLayout:
+		return "telemetry"
+	case m.usingTokensLayout:
+		return "tokens"
+	case m.usingEnvLayout:
+		return "env"
+	case m.usingRfpEditor:
+		return "rfp"
+	default:
+		return "base"
+	}
+}
+
 func (m *model) inBaseLayout() bool {
 	return !m.usingTasksLayout &&
 		!m.usingServicesLayout &&
@@ -7274,6 +10713,7 @@ func (m *model) inBaseLayout() bool {
 		!m.usingEnvLayout &&
 		!m.usingTokensLayout &&
 		!m.usingReportsLayout &&
+		!m.usingTelemetryLayout &&
 		!m.usingRfpEditor
 }
 
@@ -7322,7 +10762,9 @@ func (m *model) updateVisibleColumns() {
 		columns = append(columns, newSpacerColumn())
 	}
 
-	if m.itemsCol != nil && m.shouldShowItemsColumn() {
+	if m.docTOCVisible && m.currentFeature == "docs" && m.docTOCCol != nil {
+		columns = append(columns, m.docTOCCol)
+	} else if m.itemsCol != nil && m.shouldShowItemsColumn() {
 		columns = append(columns, m.itemsCol)
 	} else {
 		columns = append(columns, newSpacerColumn())
@@ -7379,6 +10821,8 @@ func minimumColumnWidth(col column) int {
 		return 32
 	case *reportsTableColumn:
 		return 32
+	case *telemetryTableColumn:
+		return 32
 	case *backlogTableColumn:
 		return 30
 	case *previewColumn:
@@ -7543,7 +10987,7 @@ func (m *model) adjustColumnsScroll() {
 		return
 	}
 
-	if m.focus == len(m.columnOffsets)-1 {
+	if m.focus < len(m.columnWidths) && m.columnOffsets[m.focus]+m.columnWidths[m.focus] >= m.columnsTotalWidth {
 		m.columnsScrollX = maxOffset
 		return
 	}
@@ -7668,7 +11112,14 @@ func (m *model) chatAreaWidth() int {
 
 func (m *model) focusableColumnIndices() []int {
 	indices := make([]int, 0, len(m.columns))
+	focusTarget := -1
+	if m.focusMode {
+		focusTarget = m.focusModeColumn()
+	}
 	for i, col := range m.columns {
+		if focusTarget >= 0 && i != focusTarget {
+			continue
+		}
 		if !isSpacerColumn(col) {
 			indices = append(indices, i)
 		}
@@ -7676,24 +11127,43 @@ func (m *model) focusableColumnIndices() []int {
 	return indices
 }
 
+// focusOrder lists focusable column indexes left to right as drawn, which is
+// the order tab cycles through.
+func (m *model) focusOrder() []int {
+	var order []int
+	for _, i := range m.columnDisplayOrder() {
+		if !isSpacerColumn(m.columns[i]) {
+			order = append(order, i)
+		}
+	}
+	return order
+}
+
+// focusNextColumn moves focus to the next column in focusOrder, wrapping
+// around, and skips columns hidden by focus mode.
 func (m *model) focusNextColumn() {
-	indices := m.focusableColumnIndices()
+	focusable := make(map[int]bool)
+	for _, idx := range m.focusableColumnIndices() {
+		focusable[idx] = true
+	}
+	var indices []int
+	for _, idx := range m.focusOrder() {
+		if focusable[idx] {
+			indices = append(indices, idx)
+		}
+	}
 	if len(indices) == 0 {
 		return
 	}
-	current := m.focus
 	next := indices[0]
-	for _, idx := range indices {
-		if idx > current {
-			next = idx
+	for i, idx := range indices {
+		if idx == m.focus {
+			next = indices[(i+1)%len(indices)]
 			break
 		}
 	}
-	if next == current {
-		if len(indices) == 1 {
-			return
-		}
-		next = indices[0]
+	if next == m.focus {
+		return
 	}
 	m.setFocusIndex(next)
 }
@@ -7710,7 +11180,7 @@ func (m *model) focusSlotCount() int {
 }
 
 func (m *model) currentFocusSlot() int {
-	indices := m.focusableColumnIndices()
+	indices := m.focusOrder()
 	for i, idx := range indices {
 		if idx == m.focus {
 			return i
@@ -7747,7 +11217,7 @@ func (m *model) applyFocus(target int) {
 		target = total - 1
 	}
 
-	indices := m.focusableColumnIndices()
+	indices := m.focusOrder()
 	if target < len(indices) {
 		m.setFocusIndex(indices[target])
 		return
@@ -7854,11 +11324,40 @@ func (m *model) focusLogsPanel() {
 	if m.chatFocused {
 		m.blurChatInput()
 	}
+	if m.focus >= 0 {
+		m.logsReturnFocus = m.focus
+	}
 	m.logsFocused = true
 	m.focus = -1
 	m.ensureLogsSelectionInitialized()
 }
 
+// focusLogsFromColumns shows the log panel if needed and moves keyboard focus
+// into it.
+func (m *model) focusLogsFromColumns() {
+	if !m.showLogs {
+		m.showLogs = true
+		m.refreshLogs()
+		m.applyLayout()
+		m.clampFocusAfterLayout()
+	}
+	m.focusLogsPanel()
+}
+
+// returnFocusFromLogs hands keyboard focus back to the column that had it
+// before the log panel was focused.
+func (m *model) returnFocusFromLogs() {
+	m.logsFocused = false
+	target := m.logsReturnFocus
+	for _, idx := range m.focusableColumnIndices() {
+		if idx == target {
+			m.setFocusIndex(idx)
+			return
+		}
+	}
+	m.focusNextColumn()
+}
+
 func (m *model) blurLogsPanel() {
 	if m.logsFocused {
 		m.logsFocused = false
@@ -7942,6 +11441,18 @@ func (m *model) openQuitConfirm() {
 	m.quitConfirmIndex = 0
 }
 
+func (m *model) quitConfirmMessage() string {
+	active := m.jobRunner.ActiveCount()
+	switch active {
+	case 0:
+		return "Quit gpt-creator?"
+	case 1:
+		return "1 job running — quit anyway? y/N"
+	default:
+		return fmt.Sprintf("%d jobs running — quit anyway? y/N", active)
+	}
+}
+
 func (m *model) closeQuitConfirm() {
 	m.quitConfirmActive = false
 }
@@ -8378,6 +11889,40 @@ func (m *model) useReportsLayout(enable bool) {
 	m.applyLayout()
 }
 
+func (m *model) useTelemetryLayout(enable bool) {
+	if enable {
+		if m.usingTelemetryLayout {
+			return
+		}
+		m.useRfpEditorLayout(false)
+		m.columns = []column{
+			m.workspaceCol,
+			m.featureCol,
+			m.telemetryCol,
+			m.previewCol,
+		}
+		m.usingTelemetryLayout = true
+		m.clampFocusAfterLayout()
+	} else {
+		if !m.usingTelemetryLayout {
+			return
+		}
+		if len(m.defaultColumns) == len(m.columns) && len(m.defaultColumns) > 0 {
+			m.columns = append([]column(nil), m.defaultColumns...)
+		} else {
+			m.columns = []column{
+				m.workspaceCol,
+				m.featureCol,
+				m.itemsCol,
+				m.previewCol,
+			}
+		}
+		m.usingTelemetryLayout = false
+		m.clampFocusAfterLayout()
+	}
+	m.applyLayout()
+}
+
 func (m *model) useArtifactsLayout(enable bool) {
 	if enable {
 		if m.usingArtifactsLayout {
@@ -8457,6 +12002,7 @@ func (m *model) useRfpEditorLayout(enable bool) {
 		m.useEnvLayout(false)
 		m.useTokensLayout(false)
 		m.useReportsLayout(false)
+		m.useTelemetryLayout(false)
 		m.columns = []column{
 			m.workspaceCol,
 			m.featureCol,
@@ -8546,6 +12092,14 @@ func (m *model) exitReportsView() {
 	m.useReportsLayout(false)
 }
 
+func (m *model) exitTelemetryView() {
+	if !m.usingTelemetryLayout {
+		return
+	}
+	m.useTelemetryLayout(false)
+	m.telemetryClearArmed = time.Time{}
+}
+
 func (m *model) loadEnvFilesCmd() tea.Cmd {
 	if m.currentProject == nil {
 		return nil
@@ -8718,6 +12272,16 @@ func (m *model) renderEnvPreview() string {
 		b.WriteString("Duplicates: none\n")
 	}
 
+	if conflicts := findEnvConflicts(m.envFiles); len(conflicts) > 0 {
+		b.WriteString("\nConflicts (same key, different values across files):\n")
+		for _, conflict := range conflicts {
+			b.WriteString("  " + conflict.Key + "\n")
+			for _, value := range conflict.Values {
+				b.WriteString(fmt.Sprintf("    %s: %s\n", value.File, envValueFingerprint(value.Value)))
+			}
+		}
+	}
+
 	allMissing := m.aggregateEnvMissingKeys()
 	if len(allMissing) > 0 {
 		b.WriteString("\nProject-wide missing keys:\n")
@@ -8726,7 +12290,7 @@ func (m *model) renderEnvPreview() string {
 		}
 	}
 
-	b.WriteString("\nShortcuts: enter edit • n new key • r reveal/hide • y copy • ctrl+s save\n")
+	b.WriteString("\nShortcuts: enter edit • n new key • r reveal/hide • y copy • s sort • ctrl+s save • ctrl+z revert\n")
 	b.WriteString("Secrets stay masked unless revealed; copied values are not logged.\n")
 	b.WriteString("After saving, restart affected services from Run/Services.\n")
 	return b.String()
@@ -8849,6 +12413,9 @@ func (m *model) saveCurrentEnvFile() {
 	if m.currentFeature != "env" || !m.usingEnvLayout || m.currentEnvFile == nil {
 		return
 	}
+	if m.rejectInReadOnly() {
+		return
+	}
 	state := m.currentEnvFile
 	if !state.Dirty {
 		m.setToast("No env changes to save", 3*time.Second)
@@ -8891,9 +12458,101 @@ func (m *model) saveCurrentEnvFile() {
 		m.emitTelemetry("env_saved", fields)
 	}
 	m.appendLog(fmt.Sprintf("Saved env file: %s", state.RelPath))
+	m.updateCredentialHint()
 	m.setToast("Saved. Restart affected services to apply changes.", 6*time.Second)
 }
 
+// confirmRevertEnvFile reloads the selected env file from disk, asking for
+// confirmation first when it has unsaved edits.
+func (m *model) confirmRevertEnvFile() {
+	if m.currentFeature != "env" || !m.usingEnvLayout || m.currentEnvFile == nil || m.currentProject == nil {
+		return
+	}
+	if !m.currentEnvFile.Dirty {
+		m.revertCurrentEnvFile()
+		return
+	}
+	m.pendingConfirmTitle = "discard unsaved changes to " + m.currentEnvFile.RelPath
+	m.pendingConfirmRun = func() tea.Cmd {
+		m.revertCurrentEnvFile()
+		return nil
+	}
+	m.openInput(fmt.Sprintf("Really %s? (type yes to continue)", m.pendingConfirmTitle), "", inputCommandConfirm)
+}
+
+// revertCurrentEnvFile replaces the in-memory state of the selected env file
+// with a fresh copy from disk, keeping the other files' edits.
+func (m *model) revertCurrentEnvFile() {
+	state := m.currentEnvFile
+	if state == nil || m.currentProject == nil {
+		return
+	}
+	states, err := loadEnvFiles(m.currentProject.Path)
+	if err != nil {
+		m.setToast(fmt.Sprintf("Reload failed: %v", err), 5*time.Second)
+		return
+	}
+	var fresh *envFileState
+	for _, candidate := range states {
+		if filepath.Clean(candidate.Path) == filepath.Clean(state.Path) {
+			fresh = candidate
+			break
+		}
+	}
+	if fresh == nil {
+		fresh = newEmptyEnvFile(state.Path, m.currentProject.Path)
+	}
+	*state = *fresh
+	m.envEditingFile = nil
+	m.envEditingEntry = envEntry{}
+	m.pendingEnvKey = ""
+	delete(m.envValidationNotified, state.RelPath)
+	m.refreshEnvFileList()
+	m.refreshEnvTable("")
+	m.updateEnvPreview()
+	m.emitTelemetry("env_reverted", map[string]string{
+		"path": filepath.Clean(m.currentProject.Path),
+		"file": state.RelPath,
+	})
+	m.setToast("Reloaded "+state.RelPath+" from disk", 4*time.Second)
+}
+
+// sortCurrentEnvFile orders the selected env file's keys alphabetically. The
+// change is only written on save and ctrl+z restores the on-disk order.
+func (m *model) sortCurrentEnvFile() {
+	state := m.currentEnvFile
+	if m.currentFeature != "env" || !m.usingEnvLayout || state == nil {
+		return
+	}
+	selectedKey := ""
+	if entry, ok := m.envTableCol.SelectedEntry(); ok {
+		selectedKey = entry.Key
+	}
+	if !state.sortEntries() {
+		m.setToast(state.RelPath+" is already sorted", 3*time.Second)
+		return
+	}
+	selectID := ""
+	for _, entry := range state.Entries {
+		if entry.Key == selectedKey {
+			selectID = envEntryIdentifier(entry)
+			break
+		}
+	}
+	m.refreshEnvFileList()
+	m.refreshEnvTable(selectID)
+	m.updateEnvPreview()
+	fields := map[string]string{
+		"file": state.RelPath,
+		"keys": strconv.Itoa(len(state.Entries)),
+	}
+	if m.currentProject != nil {
+		fields["path"] = filepath.Clean(m.currentProject.Path)
+	}
+	m.emitTelemetry("env_sorted", fields)
+	m.setToast("Sorted "+state.RelPath+" (ctrl+s to save, ctrl+z to undo)", 4*time.Second)
+}
+
 func (m *model) envFileTitle(state *envFileState) string {
 	label := strings.TrimSpace(state.RelPath)
 	if label == "" {
@@ -8979,36 +12638,91 @@ func (m *model) loadBacklogCmd() tea.Cmd {
 	}
 }
 
-func (m *model) computeCredentialHint() string {
-	var missing []string
-	if os.Getenv("OPENAI_API_KEY") == "" && os.Getenv("GC_OPENAI_API_KEY") == "" {
-		missing = append(missing, "OPENAI_API_KEY")
+// requiredCredentials pairs each credential the CLI needs with the variables
+// that satisfy it.
+var requiredCredentials = []struct {
+	name string
+	keys []string
+}{
+	{name: "OPENAI_API_KEY", keys: []string{"OPENAI_API_KEY", "GC_OPENAI_API_KEY"}},
+	{name: "JIRA_API_TOKEN", keys: []string{"JIRA_API_TOKEN", "GC_JIRA_API_TOKEN"}},
+}
+
+// missingCredentials lists the required credentials set neither in the
+// process environment nor in the current project's env files.
+func (m *model) missingCredentials() []string {
+	fileValues := make(map[string]bool)
+	if m.currentProject != nil {
+		if files, err := loadEnvFiles(m.currentProject.Path); err == nil {
+			for _, file := range files {
+				for _, entry := range file.Entries {
+					if strings.TrimSpace(entry.Value) != "" {
+						fileValues[entry.Key] = true
+					}
+				}
+			}
+		}
 	}
-	if os.Getenv("JIRA_API_TOKEN") == "" && os.Getenv("GC_JIRA_API_TOKEN") == "" {
-		missing = append(missing, "JIRA_API_TOKEN")
+	var missing []string
+	for _, cred := range requiredCredentials {
+		found := false
+		for _, key := range cred.keys {
+			if os.Getenv(key) != "" || fileValues[key] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, cred.name)
+		}
 	}
-	if len(missing) == 0 {
+	return missing
+}
+
+func (m *model) computeCredentialHint() string {
+	if len(m.credentialMissing) == 0 {
 		return ""
 	}
-	return fmt.Sprintf("Missing credentials: %s. Open the Env Editor to configure them.", strings.Join(missing, ", "))
+	return fmt.Sprintf("Missing credentials: %s. Open the Env Editor to configure them.", strings.Join(m.credentialMissing, ", "))
 }
 
 func (m *model) updateCredentialHint() {
+	m.credentialMissing = m.missingCredentials()
 	m.credentialHint = m.computeCredentialHint()
 }
 
+// credentialBadge is the status bar summary of credential health.
+func (m *model) credentialBadge() string {
+	if len(m.credentialMissing) == 0 {
+		return "🔑 OK"
+	}
+	return fmt.Sprintf("🔑 %d missing", len(m.credentialMissing))
+}
+
+// openCredentialsEditor jumps to the Env editor from the credential badge.
+func (m *model) openCredentialsEditor() tea.Cmd {
+	if len(m.credentialMissing) > 0 {
+		m.setToast("Missing: "+strings.Join(m.credentialMissing, ", "), 5*time.Second)
+	}
+	return m.gotoFeature("env")
+}
+
 func (m *model) buildBacklogTreeItems() []list.Item {
 	if m.backlog == nil {
 		return nil
 	}
 	items := make([]list.Item, 0, len(m.backlog.Rows))
 	for _, row := range m.backlog.Rows {
+		if m.backlogEpicsOnly && row.Type != backlogNodeEpic {
+			continue
+		}
 		entry := backlogTreeEntry{
-			title:  row.Title,
-			desc:   "",
-			node:   row.Node,
-			level:  row.Depth,
-			status: row.Status,
+			title:   row.Title,
+			desc:    "",
+			node:    row.Node,
+			level:   row.Depth,
+			status:  row.Status,
+			blocked: row.Blocked,
 		}
 		switch row.Type {
 		case backlogNodeEpic:
@@ -9059,6 +12773,20 @@ func (m *model) refreshBacklogViews() {
 	m.applyBacklogFilters()
 }
 
+// toggleBacklogEpicsOnly switches between the full backlog and an epic-level
+// rollup with per-epic progress in the table.
+func (m *model) toggleBacklogEpicsOnly() {
+	m.backlogEpicsOnly = !m.backlogEpicsOnly
+	if m.backlogEpicsOnly && m.backlogScope.Type != backlogNodeEpic {
+		m.backlogScope = backlogNode{}
+	}
+	if m.backlogEpicsOnly && m.backlogActive.Type != backlogNodeEpic {
+		m.backlogActive = backlogNode{}
+	}
+	m.refreshBacklogViews()
+	m.setToast(ternary(m.backlogEpicsOnly, "Backlog: epics summary", "Backlog: full tree"), 3*time.Second)
+}
+
 func (m *model) applyBacklogFilters() {
 	if m.backlogTable == nil {
 		return
@@ -9067,11 +12795,17 @@ func (m *model) applyBacklogFilters() {
 		m.backlogTable.SetRows(nil)
 		return
 	}
-	rows := m.backlog.FilteredRows(m.backlogFilterType, m.backlogStatusFilter, m.backlogScope)
-	m.backlogTable.SetRows(rows)
+	var rows []backlogRow
+	if m.backlogEpicsOnly {
+		rows = m.backlog.FilteredRows(backlogTypeFilterEpics, m.backlogStatusFilter, backlogNode{})
+		m.backlogTable.SetEpicRows(rows, m.backlog)
+	} else {
+		rows = m.backlog.FilteredRows(m.backlogFilterType, m.backlogStatusFilter, m.backlogScope)
+		m.backlogTable.SetRows(rows)
+	}
 	if !m.backlogActive.IsZero() {
 		m.backlogTable.SelectNode(m.backlogActive)
-	} else if len(rows) > 0 {
+	} else if len(rows) > 0 && !m.restoreColumnCursor(m.backlogTable) {
 		m.backlogTable.SelectNode(rows[0].Node)
 	}
 }
@@ -9195,11 +12929,36 @@ func (m *model) handleBacklogToggleRequest(row backlogRow) tea.Cmd {
 		m.appendLog("Task database unavailable; cannot update status.")
 		return nil
 	}
-	m.backlogActive = row.Node
 	nextStatus := "done"
 	if strings.EqualFold(row.Status, "done") {
 		nextStatus = "todo"
 	}
+	return m.updateBacklogTaskStatus(row, nextStatus)
+}
+
+// cycleBacklogTaskStatus moves the active task to the next status in
+// todo → doing → blocked → done.
+func (m *model) cycleBacklogTaskStatus() tea.Cmd {
+	if m.backlog == nil || m.backlogActive.Type != backlogNodeTask {
+		m.setToast("Select a task to change its status", 4*time.Second)
+		return nil
+	}
+	row, ok := m.backlog.RowByNode(m.backlogActive)
+	if !ok {
+		return nil
+	}
+	if m.backlog.DBPath == "" {
+		m.appendLog("Task database unavailable; cannot update status.")
+		return nil
+	}
+	return m.updateBacklogTaskStatus(row, nextBacklogStatus(row.Status))
+}
+
+func (m *model) updateBacklogTaskStatus(row backlogRow, nextStatus string) tea.Cmd {
+	if m.rejectInReadOnly() {
+		return nil
+	}
+	m.backlogActive = row.Node
 	m.appendLog(fmt.Sprintf("Updating task %s → %s", row.Key, nextStatus))
 	return func() tea.Msg {
 		err := updateTaskStatus(m.backlog.DBPath, row.Node, nextStatus)
@@ -9232,6 +12991,9 @@ func (m *model) handleBacklogStatusUpdated(msg backlogStatusUpdatedMsg) tea.Cmd
 }
 
 func (m *model) runBacklogExport() {
+	if m.rejectInReadOnly() {
+		return
+	}
 	if m.currentProject == nil || m.backlog == nil {
 		m.appendLog("No backlog available to export.")
 		return
@@ -9251,11 +13013,125 @@ func (m *model) runBacklogExport() {
 	m.setToast("backlog.csv updated", 5*time.Second)
 }
 
+// copyBacklogItemAsJira copies the selected epic, story or task as Jira wiki
+// markup.
+func (m *model) copyBacklogItemAsJira() {
+	if m.backlog == nil {
+		m.setToast("Backlog unavailable", 4*time.Second)
+		return
+	}
+	row, ok := m.backlog.RowByNode(m.backlogActive)
+	if !ok {
+		m.setToast("Select a backlog item first", 4*time.Second)
+		return
+	}
+	markup := m.backlog.jiraMarkup(row)
+	if markup == "" {
+		m.setToast("Backlog item not found", 4*time.Second)
+		return
+	}
+	if err := clipboard.WriteAll(markup); err != nil {
+		m.appendLog(fmt.Sprintf("Failed to copy Jira markup: %v", err))
+		m.setToast("Clipboard unavailable", 4*time.Second)
+		return
+	}
+	m.setToast(fmt.Sprintf("Copied %s as Jira markup", row.Key), 3*time.Second)
+}
+
+func (m *model) runBacklogJSONExport() {
+	if m.rejectInReadOnly() {
+		return
+	}
+	if m.currentProject == nil || m.backlog == nil {
+		m.appendLog("No backlog available to export.")
+		return
+	}
+	rows := m.backlog.FilteredRows(m.backlogFilterType, m.backlogStatusFilter, m.backlogScope)
+	if len(rows) == 0 {
+		m.appendLog("No rows match the current backlog filters.")
+		return
+	}
+	path := filepath.Join(m.currentProject.Path, "backlog.json")
+	if err := exportBacklogJSON(path, m.backlog, rows); err != nil {
+		m.appendLog(fmt.Sprintf("Failed to export backlog JSON: %v", err))
+		m.setToast("Backlog export failed", 6*time.Second)
+		return
+	}
+	m.appendLog(fmt.Sprintf("Backlog exported → %s", abbreviatePath(path)))
+	m.emitTelemetry("backlog_exported", map[string]string{
+		"project": filepath.Clean(m.currentProject.Path),
+		"feature": "tasks",
+		"format":  "json",
+		"rows":    strconv.Itoa(len(rows)),
+	})
+	m.setToast("backlog.json updated", 5*time.Second)
+}
+
 func (m *model) renderBacklogSummary() string {
 	if m.backlog == nil {
 		return "Backlog unavailable.\n"
 	}
-	s := m.backlog.Summary
+	lines := renderBacklogSummaryLines(m.backlog.Summary)
+	if m.credentialHint != "" {
+		lines = append(lines, "", m.credentialHint)
+	}
+	return m.withFeatureKeyHelp(strings.Join(lines, "\n") + "\n")
+}
+
+// withFeatureKeyHelp appends the current feature's keys to a placeholder
+// preview so a freshly opened feature shows what it responds to.
+func (m *model) withFeatureKeyHelp(content string) string {
+	bindings := m.keys.FeatureHelp(m.currentFeature)
+	if len(bindings) == 0 {
+		return content
+	}
+	title := m.currentFeature
+	for _, def := range featureDefinitions {
+		if def.Key == m.currentFeature {
+			title = def.Title
+			break
+		}
+	}
+	return content + "\n" + renderFeatureKeyHelp(title, bindings)
+}
+
+// exportOverviewBrief writes a Markdown brief of the current project to
+// reports/overview-<timestamp>.md.
+func (m *model) exportOverviewBrief() {
+	if m.rejectInReadOnly() {
+		return
+	}
+	if m.currentProject == nil {
+		m.setToast("Select a project first", 4*time.Second)
+		return
+	}
+	project := m.currentProject
+	projectPath := filepath.Clean(project.Path)
+	backlog := m.backlog
+	if backlog == nil || filepath.Clean(backlog.ProjectPath) != projectPath {
+		if data, err := loadBacklogData(projectPath); err == nil {
+			backlog = data
+		} else {
+			backlog = nil
+		}
+	}
+	usage, _ := readTokensUsage(filepath.Join(projectPath, ".gpt-creator", "logs", "codex-usage.ndjson"))
+	now := time.Now()
+	path, err := writeOverviewBrief(projectPath, buildOverviewBrief(project, backlog, usage, now), now)
+	if err != nil {
+		m.appendLog(fmt.Sprintf("Failed to export overview: %v", err))
+		m.setToast("Overview export failed", 5*time.Second)
+		return
+	}
+	m.appendLog("Overview exported to " + path)
+	m.setToast("Overview saved: "+abbreviatePath(path), 6*time.Second)
+	m.emitTelemetry("overview_exported", map[string]string{
+		"path": projectPath,
+		"file": path,
+	})
+}
+
+func renderBacklogSummaryLines(s backlogSummary) []string {
 	lines := []string{
 		fmt.Sprintf("Epics %d • Stories %d • Tasks %d", s.Epics, s.Stories, s.Tasks),
 		fmt.Sprintf("Done %d • Doing %d • Todo %d • Blocked %d", s.DoneTasks, s.DoingTasks, s.TodoTasks, s.BlockedTasks),
@@ -9270,10 +13146,7 @@ func (m *model) renderBacklogSummary() string {
 	if !s.LastUpdatedAt.IsZero() {
 		lines = append(lines, fmt.Sprintf("Last update %s ago", formatRelativeTime(s.LastUpdatedAt)))
 	}
-	if m.credentialHint != "" {
-		lines = append(lines, "", m.credentialHint)
-	}
-	return strings.Join(lines, "\n") + "\n"
+	return lines
 }
 
 func (m *model) renderBacklogPreview(row backlogRow) string {
@@ -9291,6 +13164,11 @@ func (m *model) renderBacklogPreview(row backlogRow) string {
 		if epic := m.backlog.EpicByKey(row.Node.EpicKey); epic != nil {
 			b.WriteString(fmt.Sprintf("Key: %s\n", canonicalEpicKey(epic)))
 			b.WriteString(fmt.Sprintf("Stories: %d\nTasks: %d\nStatus: %s\n", epic.StoryCount, epic.TaskCount, strings.ToUpper(displayStatus(epic.Status))))
+			if epic.Total > 0 {
+				b.WriteString(fmt.Sprintf("Progress: %d/%d tasks complete\n", epic.Completed, epic.Total))
+				b.WriteString(renderProgressBar(float64(epic.Completed)/float64(epic.Total), 32))
+				b.WriteRune('\n')
+			}
 			if !epic.UpdatedAt.IsZero() {
 				b.WriteString(fmt.Sprintf("Updated: %s ago\n", formatRelativeTime(epic.UpdatedAt)))
 			}
@@ -9338,14 +13216,22 @@ func (m *model) renderBacklogPreview(row backlogRow) string {
 			}
 			if task.Description != "" {
 				b.WriteString("\nDescription:\n")
-				b.WriteString(trimMultiline(task.Description, 18))
+				b.WriteString(m.backlogMarkdown(task.Description, 18))
 				b.WriteRune('\n')
 			}
 			if task.Acceptance != "" {
 				b.WriteString("\nAcceptance:\n")
-				b.WriteString(trimMultiline(task.Acceptance, 12))
+				b.WriteString(m.backlogMarkdown(task.Acceptance, 12))
 				b.WriteRune('\n')
 			}
+			if len(task.Dependencies) > 0 {
+				b.WriteString("\nBlocked by:\n")
+				m.writeBacklogTaskRefs(&b, task.Dependencies)
+			}
+			if dependents := m.backlog.Dependents(task); len(dependents) > 0 {
+				b.WriteString("\nBlocks:\n")
+				m.writeBacklogTaskRefs(&b, dependents)
+			}
 		}
 		if story := m.backlog.StoryBySlug(row.Node.StorySlug); story != nil {
 			if bundle := m.backlog.Bundles[story.Slug]; bundle != "" {
@@ -9358,14 +13244,51 @@ func (m *model) renderBacklogPreview(row backlogRow) string {
 	return b.String()
 }
 
-func trimMultiline(input string, limit int) string {
-	text := strings.TrimSpace(input)
-	if text == "" {
-		return ""
+// writeBacklogTaskRefs lists task keys with the status and title of the
+// referenced task, flagging dependencies that are not done yet.
+func (m *model) writeBacklogTaskRefs(b *strings.Builder, keys []string) {
+	for _, key := range keys {
+		task := m.backlog.TaskByKey(key)
+		if task == nil {
+			fmt.Fprintf(b, "  - %s (not in backlog)\n", key)
+			continue
+		}
+		marker := "-"
+		if task.Status != "done" {
+			marker = "⚠"
+		}
+		fmt.Fprintf(b, "  %s %s [%s] %s\n", marker, key, strings.ToUpper(displayStatus(task.Status)), safeTitle(task.Title))
 	}
-	lines := strings.Split(text, "\n")
-	if len(lines) > limit {
-		lines = append(lines[:limit], "…")
+}
+
+// backlogMarkdown truncates text to limit source lines and renders it as
+// markdown unless the raw toggle is on.
+func (m *model) backlogMarkdown(text string, limit int) string {
+	trimmed := trimMultiline(text, limit)
+	if m.backlogRawMarkdown || trimmed == "" {
+		return trimmed
+	}
+	return strings.Trim(RenderMarkdown(trimmed), "\n")
+}
+
+func (m *model) toggleBacklogRawMarkdown() {
+	m.backlogRawMarkdown = !m.backlogRawMarkdown
+	if m.backlog != nil && m.backlogActive.Type == backlogNodeTask {
+		if row, ok := m.backlog.RowByNode(m.backlogActive); ok {
+			m.previewCol.SetContent(m.renderBacklogPreview(row))
+		}
+	}
+	m.setToast(ternary(m.backlogRawMarkdown, "Task preview: raw markdown", "Task preview: rendered markdown"), 3*time.Second)
+}
+
+func trimMultiline(input string, limit int) string {
+	text := strings.TrimSpace(input)
+	if text == "" {
+		return ""
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) > limit {
+		lines = append(lines[:limit], "…")
 	}
 	return strings.Join(lines, "\n")
 }
@@ -9394,6 +13317,9 @@ func (m *model) loadTokensUsageCmd() tea.Cmd {
 }
 
 func (m *model) handleTokensLoaded(msg tokensLoadedMsg) tea.Cmd {
+	if msg.refresh && m.currentFeature != "tokens" {
+		return nil
+	}
 	m.tokensLoading = false
 	m.tokensError = msg.err
 	m.tokensUsage = msg.usage
@@ -9409,7 +13335,12 @@ func (m *model) handleTokensLoaded(msg tokensLoadedMsg) tea.Cmd {
 		}
 		return nil
 	}
-	cmd := m.refreshTokensView(true)
+	prevRow := m.tokensCurrentRow
+	cmd := m.refreshTokensView(!msg.refresh)
+	if msg.refresh && m.tokensCurrentRow == prevRow {
+		// Leave the preview and its scroll position alone.
+		cmd = nil
+	}
 	if !m.tokensTelemetrySent && m.currentProject != nil {
 		fields := map[string]string{
 			"path":    filepath.Clean(m.currentProject.Path),
@@ -9432,6 +13363,9 @@ func (m *model) handleTokensLoaded(msg tokensLoadedMsg) tea.Cmd {
 }
 
 func (m *model) handleReportsLoaded(msg reportsLoadedMsg) tea.Cmd {
+	if msg.refresh && m.currentFeature != "reports" {
+		return nil
+	}
 	m.reportsLoading = false
 	m.reportsError = msg.err
 	if msg.err != nil {
@@ -9465,7 +13399,13 @@ func (m *model) handleReportsLoaded(msg reportsLoadedMsg) tea.Cmd {
 		return nil
 	}
 	m.reportsCol.SetEntries(msg.entries)
+	if m.currentReportKey == "" {
+		m.restoreColumnCursor(m.reportsCol)
+	}
 	if m.currentReportKey != "" && m.reportsCol.SelectKey(m.currentReportKey) {
+		if msg.refresh {
+			return nil
+		}
 		if entry, ok := m.reportsCol.SelectedEntry(); ok {
 			return func() tea.Msg { return reportsRowSelectedMsg{entry: entry} }
 		}
@@ -9477,13 +13417,151 @@ func (m *model) handleReportsLoaded(msg reportsLoadedMsg) tea.Cmd {
 	return nil
 }
 
-func (m *model) handleReportsRowSelected(msg reportsRowSelectedMsg) {
+func (m *model) handleReportsRowSelected(msg reportsRowSelectedMsg) tea.Cmd {
 	entry := msg.entry
 	m.currentReportKey = entry.Key
 	m.previewCol.SetContent(m.renderReportPreview(entry))
 	if msg.activate {
-		m.openReportEntry(entry)
+		return m.openReportEntry(entry)
+	}
+	return nil
+}
+
+func (m *model) reloadTelemetryEvents() tea.Cmd {
+	m.telemetryLoading = true
+	m.telemetryCol.SetPlaceholder("Loading telemetry events…")
+	m.previewCol.SetContent(m.withFeatureKeyHelp("Loading telemetry events…\n"))
+	path := telemetryLogPath()
+	return func() tea.Msg {
+		events, err := readTelemetryEvents(path, telemetryViewerLimit)
+		return telemetryLoadedMsg{events: events, err: err}
+	}
+}
+
+func (m *model) handleTelemetryLoaded(msg telemetryLoadedMsg) {
+	m.telemetryLoading = false
+	if msg.err != nil && !os.IsNotExist(msg.err) {
+		m.telemetryEvents = nil
+		m.telemetryCol.SetPlaceholder("Failed to read telemetry log.")
+		m.previewCol.SetContent(fmt.Sprintf("Failed to read %s:\n%v\n", abbreviatePath(telemetryLogPath()), msg.err))
+		return
+	}
+	m.telemetryEvents = msg.events
+	m.applyTelemetryFilter()
+}
+
+// applyTelemetryFilter narrows the loaded events to those matching the current
+// filter and refreshes the table and preview.
+func (m *model) applyTelemetryFilter() {
+	if m.telemetryCol == nil {
+		return
+	}
+	title := "Telemetry"
+	if m.telemetryFilter != "" {
+		title = fmt.Sprintf("Telemetry · /%s", m.telemetryFilter)
+	}
+	m.telemetryCol.SetTitle(title)
+	var events []telemetryEvent
+	for _, event := range m.telemetryEvents {
+		if telemetryEventMatches(event, m.telemetryFilter) {
+			events = append(events, event)
+		}
+	}
+	if len(events) == 0 {
+		m.telemetryCol.SetEvents(nil)
+		if len(m.telemetryEvents) == 0 {
+			m.telemetryCol.SetPlaceholder("No telemetry events recorded.")
+		} else {
+			m.telemetryCol.SetPlaceholder("No events match the filter.")
+		}
+		var b strings.Builder
+		b.WriteString("No telemetry events to show.\n\n")
+		if !m.settingsTelemetry {
+			b.WriteString("Telemetry is turned off in Settings, so no new events are recorded.\n")
+		}
+		b.WriteString("Log file: " + abbreviatePath(telemetryLogPath()) + "\n")
+		b.WriteString("Shortcuts: / filter • c clear filter • r reload • o open log • X clear log\n")
+		m.previewCol.SetContent(b.String())
+		return
+	}
+	m.telemetryCol.SetEvents(events)
+	m.restoreColumnCursor(m.telemetryCol)
+	if event, ok := m.telemetryCol.SelectedEvent(); ok {
+		m.previewCol.SetContent(renderTelemetryPreview(event))
+	}
+}
+
+func (m *model) openTelemetryLog() tea.Cmd {
+	path := telemetryLogPath()
+	if _, err := os.Stat(path); err != nil {
+		m.setToast("Telemetry log not found", 4*time.Second)
+		return nil
+	}
+	commandLine, editorCmd, err := launchEditor(path)
+	if err != nil {
+		m.appendLog(fmt.Sprintf("Failed to open telemetry log: %v", err))
+		m.setToast("Failed to open telemetry log", 5*time.Second)
+		return nil
+	}
+	m.appendLog("Opening telemetry log: " + commandLine)
+	m.setToast("Opening telemetry log", 3*time.Second)
+	return editorCmd
+}
+
+// clearTelemetryLog truncates the event log. The first press arms the action
+// and a second press within a few seconds performs it.
+func (m *model) clearTelemetryLog() tea.Cmd {
+	if m.rejectInReadOnly() {
+		return nil
+	}
+	if m.telemetryClearArmed.IsZero() || time.Since(m.telemetryClearArmed) > 5*time.Second {
+		m.telemetryClearArmed = time.Now()
+		m.setToast("Press X again to clear the telemetry log", 5*time.Second)
+		return nil
 	}
+	m.telemetryClearArmed = time.Time{}
+	if err := m.telemetry.Clear(); err != nil {
+		m.appendLog(fmt.Sprintf("Failed to clear telemetry log: %v", err))
+		m.setToast("Failed to clear telemetry log", 5*time.Second)
+		return nil
+	}
+	m.setToast("Telemetry log cleared", 3*time.Second)
+	return m.reloadTelemetryEvents()
+}
+
+func renderTelemetryPreview(event telemetryEvent) string {
+	var b strings.Builder
+	b.WriteString(event.Event)
+	b.WriteRune('\n')
+	b.WriteString(strings.Repeat("─", len(event.Event)))
+	b.WriteString("\n\n")
+	if !event.Timestamp.IsZero() {
+		ts := event.Timestamp.Local()
+		b.WriteString(fmt.Sprintf("Time: %s (%s ago)\n", ts.Format(time.RFC822), formatRelativeTime(ts)))
+	}
+	if event.Project != "" {
+		b.WriteString("Project: " + abbreviatePath(event.Project) + "\n")
+	}
+	if event.Feature != "" {
+		b.WriteString("Feature: " + event.Feature + "\n")
+	}
+	if event.ItemID != "" {
+		b.WriteString("Item: " + event.ItemID + "\n")
+	}
+	b.WriteString("Session: " + event.SessionID + "\n")
+	if len(event.ExtraJSON) > 0 {
+		keys := make([]string, 0, len(event.ExtraJSON))
+		for key := range event.ExtraJSON {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		b.WriteString("\nFields:\n")
+		for _, key := range keys {
+			b.WriteString(fmt.Sprintf("  %s: %s\n", key, event.ExtraJSON[key]))
+		}
+	}
+	b.WriteString("\nShortcuts: / filter • c clear filter • r reload • o open log • X clear log\n")
+	return b.String()
 }
 
 func (m *model) refreshSettingsItems() {
@@ -9517,7 +13595,7 @@ func (m *model) refreshSettingsItems() {
 }
 
 func (m *model) buildSettingsItems() []featureItemDefinition {
-	items := make([]featureItemDefinition, 0, 5)
+	items := make([]featureItemDefinition, 0, 8)
 
 	desc, preview := m.settingsWorkspaceInfo()
 	items = append(items, featureItemDefinition{
@@ -9552,6 +13630,61 @@ func (m *model) buildSettingsItems() []featureItemDefinition {
 		},
 	})
 
+	desc, preview = m.settingsServicesPollInfo()
+	items = append(items, featureItemDefinition{
+		Key:   "settings-services-poll",
+		Title: "Services poll",
+		Desc:  desc,
+		Meta: map[string]string{
+			"settings":        "services_poll",
+			"settingsPreview": preview,
+		},
+	})
+
+	desc, preview = m.settingsDashboardRefreshInfo()
+	items = append(items, featureItemDefinition{
+		Key:   "settings-dashboard-refresh",
+		Title: "Dashboard refresh",
+		Desc:  desc,
+		Meta: map[string]string{
+			"settings":        "dashboard_refresh",
+			"settingsPreview": preview,
+		},
+	})
+
+	desc, preview = m.settingsLogLinesInfo()
+	items = append(items, featureItemDefinition{
+		Key:   "settings-log-lines",
+		Title: "Log history",
+		Desc:  desc,
+		Meta: map[string]string{
+			"settings":        "log_lines",
+			"settingsPreview": preview,
+		},
+	})
+
+	desc, preview = m.settingsLayoutInfo()
+	items = append(items, featureItemDefinition{
+		Key:   "settings-layout",
+		Title: "Column layout",
+		Desc:  desc,
+		Meta: map[string]string{
+			"settings":        "column_layout",
+			"settingsPreview": preview,
+		},
+	})
+
+	desc, preview = m.settingsFeaturesInfo()
+	items = append(items, featureItemDefinition{
+		Key:   "settings-features",
+		Title: "Features",
+		Desc:  desc,
+		Meta: map[string]string{
+			"settings":        "features",
+			"settingsPreview": preview,
+		},
+	})
+
 	desc, preview = m.settingsDockerInfo()
 	items = append(items, featureItemDefinition{
 		Key:   "settings-docker",
@@ -9563,17 +13696,96 @@ func (m *model) buildSettingsItems() []featureItemDefinition {
 		},
 	})
 
-	desc, preview = m.settingsUpdateInfo()
+	desc, preview = m.settingsTelemetryInfo()
 	items = append(items, featureItemDefinition{
-		Key:   "settings-update",
-		Title: "Update",
+		Key:   "settings-telemetry",
+		Title: "Telemetry",
 		Desc:  desc,
 		Meta: map[string]string{
-			"settings":        "update",
+			"settings":        "telemetry",
 			"settingsPreview": preview,
 		},
 	})
 
+	desc, preview = m.settingsConfirmInfo()
+	items = append(items, featureItemDefinition{
+		Key:   "settings-confirm",
+		Title: "Confirmations",
+		Desc:  desc,
+		Meta: map[string]string{
+			"settings":        "confirm",
+			"settingsPreview": preview,
+		},
+	})
+
+	desc, preview = m.settingsDryRunInfo()
+	items = append(items, featureItemDefinition{
+		Key:   "settings-dry-run",
+		Title: "Dry run",
+		Desc:  desc,
+		Meta: map[string]string{
+			"settings":        "dry-run",
+			"settingsPreview": preview,
+		},
+	})
+
+	desc, preview = m.settingsAutoWatchInfo()
+	items = append(items, featureItemDefinition{
+		Key:   "settings-auto-watch",
+		Title: "Auto watch",
+		Desc:  desc,
+		Meta: map[string]string{
+			"settings":        "auto-watch",
+			"settingsPreview": preview,
+		},
+	})
+
+	desc, preview = m.settingsAutoVerifyInfo()
+	items = append(items, featureItemDefinition{
+		Key:   "settings-auto-verify",
+		Title: "Auto verify",
+		Desc:  desc,
+		Meta: map[string]string{
+			"settings":        "auto-verify",
+			"settingsPreview": preview,
+		},
+	})
+
+	desc, preview = m.settingsInlineImagesInfo()
+	items = append(items, featureItemDefinition{
+		Key:   "settings-inline-images",
+		Title: "Inline images",
+		Desc:  desc,
+		Meta: map[string]string{
+			"settings":        "inline-images",
+			"settingsPreview": preview,
+		},
+	})
+
+	desc, preview = m.settingsDenseListsInfo()
+	items = append(items, featureItemDefinition{
+		Key:   "settings-dense-lists",
+		Title: "Dense lists",
+		Desc:  desc,
+		Meta: map[string]string{
+			"settings":        "dense-lists",
+			"settingsPreview": preview,
+		},
+	})
+
+	if m.currentCommandPolicy().allows("settings-update", []string{"update"}) {
+		desc, preview = m.settingsUpdateInfo()
+		items = append(items, featureItemDefinition{
+			Key:   "settings-update",
+			Title: "Update",
+			Desc:  desc,
+			Meta: map[string]string{
+				"settings":        "update",
+				"settingsPreview": preview,
+			},
+		})
+	}
+
 	return items
 }
 
@@ -9612,8 +13824,41 @@ func (m *model) activateSettingsItem(item featureItemDefinition) tea.Cmd {
 		return nil
 	case "settings-concurrency":
 		return m.promptSettingsConcurrency()
+	case "settings-services-poll":
+		return m.promptServicesPoll()
+	case "settings-dashboard-refresh":
+		return m.promptDashboardRefresh()
+	case "settings-log-lines":
+		return m.promptLogLines()
+	case "settings-layout":
+		m.cycleColumnArrangement(1)
+		return nil
+	case "settings-features":
+		m.openFeatureVisibility()
+		return nil
 	case "settings-docker":
 		return m.promptDockerPath()
+	case "settings-telemetry":
+		m.setTelemetrySetting(!m.settingsTelemetry)
+		return nil
+	case "settings-dry-run":
+		m.setDryRunSetting(!m.settingsDryRun)
+		return nil
+	case "settings-auto-watch":
+		m.setAutoWatchSetting(!m.settingsAutoWatch)
+		return nil
+	case "settings-auto-verify":
+		m.setAutoVerifySetting(!m.settingsAutoVerify)
+		return nil
+	case "settings-inline-images":
+		m.setInlineImagesSetting(!m.settingsInlineImages)
+		return nil
+	case "settings-dense-lists":
+		m.setDenseListsSetting(!m.settingsDenseLists)
+		return nil
+	case "settings-confirm":
+		m.promptConfirmCommands()
+		return nil
 	case "settings-update":
 		return m.runUpdate(false)
 	default:
@@ -9654,6 +13899,9 @@ func (m *model) handleSettingsKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 		case "3":
 			m.setThemeSetting(markdownThemeLight)
 			return true, nil
+		case "4":
+			m.setThemeSetting(markdownThemeHighContrast)
+			return true, nil
 		}
 	case "settings-concurrency":
 		switch msg.String() {
@@ -9664,6 +13912,60 @@ func (m *model) handleSettingsKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 		case "-", "_":
 			return true, m.adjustConcurrency(-1)
 		}
+	case "settings-services-poll":
+		switch msg.String() {
+		case "enter":
+			return true, m.promptServicesPoll()
+		case "+", "=":
+			return true, m.adjustServicesPoll(1)
+		case "-", "_":
+			return true, m.adjustServicesPoll(-1)
+		case "0":
+			return true, m.setServicesPoll(0)
+		}
+	case "settings-dashboard-refresh":
+		switch msg.String() {
+		case "enter":
+			return true, m.promptDashboardRefresh()
+		case "+", "=":
+			m.adjustDashboardRefresh(dashboardRefreshStep)
+			return true, nil
+		case "-", "_":
+			m.adjustDashboardRefresh(-dashboardRefreshStep)
+			return true, nil
+		case "0":
+			m.setDashboardRefresh(0)
+			return true, nil
+		}
+	case "settings-log-lines":
+		switch msg.String() {
+		case "enter":
+			return true, m.promptLogLines()
+		case "+", "=":
+			m.setLogLines(m.settingsLogLines + logLinesStep)
+			return true, nil
+		case "-", "_":
+			m.setLogLines(m.settingsLogLines - logLinesStep)
+			return true, nil
+		}
+	case "settings-layout":
+		switch msg.String() {
+		case "enter", " ", "+", "=":
+			m.cycleColumnArrangement(1)
+			return true, nil
+		case "-", "_":
+			m.cycleColumnArrangement(-1)
+			return true, nil
+		}
+	case "settings-features":
+		switch msg.String() {
+		case "enter", " ":
+			m.openFeatureVisibility()
+			return true, nil
+		case "r", "R":
+			m.showAllFeatures()
+			return true, nil
+		}
 	case "settings-docker":
 		switch msg.String() {
 		case "enter":
@@ -9671,6 +13973,53 @@ func (m *model) handleSettingsKey(msg tea.KeyMsg) (bool, tea.Cmd) {
 		case "c", "C":
 			m.clearDockerPath()
 			return true, nil
+		case "t", "T":
+			return true, m.startDockerCheck()
+		}
+	case "settings-telemetry":
+		switch msg.String() {
+		case "enter", " ":
+			m.setTelemetrySetting(!m.settingsTelemetry)
+			return true, nil
+		}
+	case "settings-dry-run":
+		switch msg.String() {
+		case "enter", " ":
+			m.setDryRunSetting(!m.settingsDryRun)
+			return true, nil
+		}
+	case "settings-auto-watch":
+		switch msg.String() {
+		case "enter", " ":
+			m.setAutoWatchSetting(!m.settingsAutoWatch)
+			return true, nil
+		}
+	case "settings-auto-verify":
+		switch msg.String() {
+		case "enter", " ":
+			m.setAutoVerifySetting(!m.settingsAutoVerify)
+			return true, nil
+		}
+	case "settings-inline-images":
+		switch msg.String() {
+		case "enter", " ":
+			m.setInlineImagesSetting(!m.settingsInlineImages)
+			return true, nil
+		}
+	case "settings-dense-lists":
+		switch msg.String() {
+		case "enter", " ":
+			m.setDenseListsSetting(!m.settingsDenseLists)
+			return true, nil
+		}
+	case "settings-confirm":
+		switch msg.String() {
+		case "enter":
+			m.promptConfirmCommands()
+			return true, nil
+		case "r", "R":
+			m.setConfirmCommands(append([]string(nil), defaultConfirmCommands...))
+			return true, nil
 		}
 	case "settings-update":
 		switch msg.String() {
@@ -9714,7 +14063,8 @@ func (m *model) settingsThemeInfo() (string, string) {
 	var b strings.Builder
 	b.WriteString("Theme\n────────\n")
 	b.WriteString(fmt.Sprintf("Current: %s\n", label))
-	b.WriteString("\nEnter cycle • 1 auto • 2 dark • 3 light\n")
+	b.WriteString("\nEnter cycle • 1 auto • 2 dark • 3 light • 4 high contrast\n")
+	b.WriteString("High contrast also switches the interface to black, white and yellow.\n")
 	return desc, b.String()
 }
 
@@ -9727,6 +14077,48 @@ func (m *model) settingsConcurrencyInfo() (string, string) {
 	return desc, b.String()
 }
 
+func (m *model) settingsServicesPollInfo() (string, string) {
+	desc := fmt.Sprintf("Every %ds", m.settingsServicesPoll)
+	if m.settingsServicesPoll <= 0 {
+		desc = "Auto-refresh off"
+	}
+	var b strings.Builder
+	b.WriteString("Services Poll\n──────────────\n")
+	if m.settingsServicesPoll <= 0 {
+		b.WriteString("Auto-polling disabled. Refresh the services view manually.\n")
+	} else {
+		b.WriteString(fmt.Sprintf("Health checks refresh every %d second(s)\n", m.settingsServicesPoll))
+	}
+	b.WriteString(fmt.Sprintf("\n+ increase • - decrease • 0 disable • Enter set value (0–%d)\n", maxServicesPollSeconds))
+	return desc, b.String()
+}
+
+func (m *model) settingsDashboardRefreshInfo() (string, string) {
+	desc := fmt.Sprintf("Every %ds", m.settingsDashboardRefresh)
+	if m.settingsDashboardRefresh <= 0 {
+		desc = "Auto-refresh off"
+	}
+	var b strings.Builder
+	b.WriteString("Dashboard Refresh\n──────────────────\n")
+	if m.settingsDashboardRefresh <= 0 {
+		b.WriteString("Tokens and reports reload on entry and after jobs only.\n")
+	} else {
+		b.WriteString(fmt.Sprintf("Tokens and reports reload every %d second(s) while open\n", m.settingsDashboardRefresh))
+	}
+	b.WriteString(fmt.Sprintf("\n+ increase • - decrease • 0 disable • Enter set value (0–%d)\n", maxDashboardRefreshSeconds))
+	return desc, b.String()
+}
+
+func (m *model) settingsLogLinesInfo() (string, string) {
+	desc := fmt.Sprintf("Keep %d lines", m.settingsLogLines)
+	var b strings.Builder
+	b.WriteString("Log History\n────────────\n")
+	b.WriteString(fmt.Sprintf("The combined log keeps the last %d line(s); older output is dropped.\n", m.settingsLogLines))
+	b.WriteString("Raise it to debug long create-project runs, lower it to save memory.\n")
+	b.WriteString(fmt.Sprintf("\n+ increase • - decrease (by %d) • Enter set value (%d–%d)\n", logLinesStep, minLogLines, maxLogLines))
+	return desc, b.String()
+}
+
 func (m *model) settingsDockerInfo() (string, string) {
 	path := strings.TrimSpace(m.settingsDockerPath)
 	desc := "Docker: Auto"
@@ -9748,7 +14140,132 @@ func (m *model) settingsDockerInfo() (string, string) {
 		}
 		b.WriteString(fmt.Sprintf("Path: %s\nStatus: %s\n", path, status))
 	}
-	b.WriteString("\nEnter choose path • C clear override\n")
+	if m.dockerUnavailableReason != "" {
+		b.WriteString("Last check: " + m.dockerUnavailableReason + "\n")
+	}
+	b.WriteString("\nEnter choose path • C clear override • T check readiness\n")
+	return desc, b.String()
+}
+
+func (m *model) settingsTelemetryInfo() (string, string) {
+	path := telemetryLogPath()
+	desc := "Telemetry: On"
+	if !m.settingsTelemetry {
+		desc = "Telemetry: Off"
+	}
+	var b strings.Builder
+	b.WriteString("Telemetry\n─────────\n")
+	if m.settingsTelemetry {
+		b.WriteString("UI events are recorded locally.\n")
+	} else {
+		b.WriteString("UI event logging is disabled.\n")
+	}
+	b.WriteString(fmt.Sprintf("Log file: %s\n", abbreviatePath(path)))
+	b.WriteString("\nEnter toggle\n")
+	return desc, b.String()
+}
+
+func (m *model) settingsConfirmInfo() (string, string) {
+	desc := "Confirm: none"
+	if len(m.confirmCommands) > 0 {
+		desc = "Confirm: " + strings.Join(m.confirmCommands, ", ")
+	}
+	var b strings.Builder
+	b.WriteString("Confirmations\n─────────────\n")
+	b.WriteString("Commands listed here ask you to type yes before they run.\n")
+	b.WriteString("Entries match an item key (run-down) or the command itself (create-project).\n\n")
+	if len(m.confirmCommands) == 0 {
+		b.WriteString("No commands require confirmation.\n")
+	} else {
+		for _, key := range m.confirmCommands {
+			b.WriteString("• " + key + "\n")
+		}
+	}
+	b.WriteString(fmt.Sprintf("\nDefaults: %s\n", strings.Join(defaultConfirmCommands, ", ")))
+	b.WriteString("\nEnter edit • r restore defaults\n")
+	return desc, b.String()
+}
+
+func (m *model) settingsFeaturesInfo() (string, string) {
+	var hidden []string
+	for _, def := range featureDefinitions {
+		if m.hiddenFeatures[def.Key] {
+			hidden = append(hidden, def.Title)
+		}
+	}
+	desc := "All features shown"
+	if len(hidden) > 0 {
+		desc = fmt.Sprintf("%d hidden", len(hidden))
+	}
+	var b strings.Builder
+	b.WriteString("Features\n────────\n")
+	b.WriteString("Hide features you do not use from the feature column.\n")
+	b.WriteString("Their commands stay available from the palette.\n\n")
+	if len(hidden) == 0 {
+		b.WriteString("No features hidden.\n")
+	} else {
+		for _, title := range hidden {
+			b.WriteString("• " + title + "\n")
+		}
+	}
+	b.WriteString("\nEnter choose features • r show all\n")
+	return desc, b.String()
+}
+
+func (m *model) settingsLayoutInfo() (string, string) {
+	desc := "Column layout: " + columnArrangementLabel(m.columnArrangement)
+	var b strings.Builder
+	b.WriteString("Column layout\n─────────────\n")
+	for _, arrangement := range columnArrangements {
+		marker := "  "
+		if arrangement == m.columnArrangement {
+			marker = "• "
+		}
+		b.WriteString(marker + columnArrangementLabel(arrangement) + "\n")
+	}
+	b.WriteString("\nDefault keeps projects on the left and the preview on the right.\nPreview left draws the preview first; wide preview gives it extra width.\n")
+	b.WriteString("\nEnter/+ next • - previous\n")
+	return desc, b.String()
+}
+
+func (m *model) cycleColumnArrangement(delta int) {
+	index := 0
+	for i, arrangement := range columnArrangements {
+		if arrangement == m.columnArrangement {
+			index = i
+			break
+		}
+	}
+	count := len(columnArrangements)
+	m.setColumnArrangement(columnArrangements[((index+delta)%count+count)%count])
+}
+
+func (m *model) setColumnArrangement(arrangement columnArrangement) {
+	if arrangement == m.columnArrangement {
+		return
+	}
+	m.columnArrangement = arrangement
+	m.applyLayout()
+	m.writeUIConfig()
+	m.emitSettingsChanged("column_layout", string(arrangement))
+	m.setToast("Column layout: "+columnArrangementLabel(arrangement), 3*time.Second)
+	m.refreshSettingsItems()
+}
+
+func (m *model) settingsDryRunInfo() (string, string) {
+	desc := "Dry run: Off"
+	if m.settingsDryRun {
+		desc = "Dry run: On"
+	}
+	var b strings.Builder
+	b.WriteString("Dry run\n───────\n")
+	if m.settingsDryRun {
+		b.WriteString("Commands are logged but not executed.\n")
+	} else {
+		b.WriteString("Commands run normally.\n")
+	}
+	b.WriteString("When on, running an item or palette command writes the resolved\ngpt-creator invocation to the logs instead of queuing a job.\n")
+	b.WriteString("\nEnter toggle\n")
 	return desc, b.String()
 }
 
@@ -9778,9 +14295,11 @@ func (m *model) cycleThemeSetting(step int) {
 	if step < 0 {
 		switch m.markdownTheme {
 		case markdownThemeAuto:
-			next = markdownThemeLight
+			next = markdownThemeHighContrast
 		case markdownThemeDark:
 			next = markdownThemeAuto
+		case markdownThemeHighContrast:
+			next = markdownThemeLight
 		default:
 			next = markdownThemeDark
 		}
@@ -9789,15 +14308,36 @@ func (m *model) cycleThemeSetting(step int) {
 }
 
 func (m *model) setThemeSetting(theme markdownTheme) {
-	if theme == m.markdownTheme {
+	// Picking the theme a --theme-ephemeral launch is showing still saves it.
+	if theme == m.markdownTheme && !m.themeEphemeral {
 		return
 	}
-	m.applyMarkdownTheme(theme, true)
+	m.themeEphemeral = false
+	if theme != m.markdownTheme {
+		m.applyMarkdownTheme(theme, true)
+	}
 	m.writeUIConfig()
 	m.emitSettingsChanged("theme", theme.String())
 	m.refreshSettingsItems()
 }
 
+// applyThemeFlag applies a theme given on the command line, overriding the
+// saved one. With persist it is saved like a theme picked in settings, so
+// later launches without the flag keep it; auto is never saved. Otherwise
+// the saved theme is left alone until the theme is changed in the UI.
+func (m *model) applyThemeFlag(theme markdownTheme, persist bool) {
+	m.applyMarkdownTheme(theme, false)
+	if !persist || theme == markdownThemeAuto || m.readOnly {
+		m.themeEphemeral = true
+		return
+	}
+	if m.uiConfig != nil && m.uiConfig.Theme == theme.String() {
+		return
+	}
+	m.writeUIConfig()
+	m.emitSettingsChanged("theme", theme.String())
+}
+
 func (m *model) promptAddWorkspaceRoot() tea.Cmd {
 	return m.openPathPicker("Add workspace root", "", inputSettingsWorkspaceAdd, true, false)
 }
@@ -9828,26 +14368,440 @@ func (m *model) adjustConcurrency(delta int) tea.Cmd {
 	if value > 8 {
 		value = 8
 	}
-	return m.setConcurrency(value)
+	return m.setConcurrency(value)
+}
+
+func (m *model) setConcurrency(value int) tea.Cmd {
+	if value < 1 {
+		value = 1
+	}
+	if value == m.settingsConcurrency {
+		return nil
+	}
+	m.settingsConcurrency = value
+	var cmd tea.Cmd
+	if m.jobRunner != nil {
+		cmd = m.jobRunner.SetMaxParallel(value)
+	}
+	m.writeUIConfig()
+	m.emitSettingsChanged("concurrency", strconv.Itoa(value))
+	m.setToast(fmt.Sprintf("Max background jobs: %d", value), 4*time.Second)
+	m.refreshSettingsItems()
+	return cmd
+}
+
+func (m *model) promptServicesPoll() tea.Cmd {
+	m.openInput("Services poll interval (seconds, 0 disables)", strconv.Itoa(m.settingsServicesPoll), inputSettingsServicesPoll)
+	return nil
+}
+
+func (m *model) adjustServicesPoll(delta int) tea.Cmd {
+	value := m.settingsServicesPoll + delta
+	if value < 0 {
+		value = 0
+	}
+	if value > maxServicesPollSeconds {
+		value = maxServicesPollSeconds
+	}
+	return m.setServicesPoll(value)
+}
+
+func (m *model) promptDashboardRefresh() tea.Cmd {
+	m.openInput(fmt.Sprintf("Dashboard refresh interval (seconds, 0 disables, max %d)", maxDashboardRefreshSeconds), strconv.Itoa(m.settingsDashboardRefresh), inputSettingsDashboardRefresh)
+	return nil
+}
+
+func (m *model) adjustDashboardRefresh(delta int) {
+	m.setDashboardRefresh(m.settingsDashboardRefresh + delta)
+}
+
+// setDashboardRefresh stores the tokens/reports refresh interval; the timer
+// picks it up the next time either view opens.
+func (m *model) setDashboardRefresh(seconds int) {
+	seconds = min(max(seconds, 0), maxDashboardRefreshSeconds)
+	if seconds == m.settingsDashboardRefresh {
+		return
+	}
+	m.settingsDashboardRefresh = seconds
+	m.writeUIConfig()
+	m.emitSettingsChanged("dashboard_refresh", strconv.Itoa(seconds))
+	if seconds == 0 {
+		m.setToast("Dashboard auto-refresh disabled", 4*time.Second)
+	} else {
+		m.setToast(fmt.Sprintf("Tokens and reports refresh every %ds", seconds), 4*time.Second)
+	}
+	m.refreshSettingsItems()
+}
+
+func (m *model) promptLogLines() tea.Cmd {
+	m.openInput(fmt.Sprintf("Log history lines (%d–%d)", minLogLines, maxLogLines), strconv.Itoa(m.settingsLogLines), inputSettingsLogLines)
+	return nil
+}
+
+// setLogLines changes the log history cap and trims the current log right
+// away when it shrinks.
+func (m *model) setLogLines(lines int) {
+	lines = min(max(lines, minLogLines), maxLogLines)
+	if lines == m.settingsLogLines {
+		return
+	}
+	m.settingsLogLines = lines
+	m.trimLogLines()
+	m.refreshLogs()
+	m.writeUIConfig()
+	m.emitSettingsChanged("log_lines", strconv.Itoa(lines))
+	m.setToast(fmt.Sprintf("Log history: %d lines", lines), 4*time.Second)
+	m.refreshSettingsItems()
+}
+
+func (m *model) setServicesPoll(seconds int) tea.Cmd {
+	if seconds < 0 {
+		seconds = 0
+	}
+	if seconds == m.settingsServicesPoll {
+		return nil
+	}
+	m.settingsServicesPoll = seconds
+	m.writeUIConfig()
+	m.emitSettingsChanged("services_poll", strconv.Itoa(seconds))
+	if seconds == 0 {
+		m.setToast("Services auto-polling disabled", 4*time.Second)
+	} else {
+		m.setToast(fmt.Sprintf("Services poll every %ds", seconds), 4*time.Second)
+	}
+	m.refreshSettingsItems()
+	return nil
+}
+
+func (m *model) setTelemetrySetting(enabled bool) {
+	if enabled == m.settingsTelemetry {
+		return
+	}
+	m.settingsTelemetry = enabled
+	m.telemetry.SetEnabled(enabled)
+	if enabled {
+		m.emitSettingsChanged("telemetry", "on")
+		m.setToast("Telemetry enabled", 4*time.Second)
+	} else {
+		m.setToast("Telemetry disabled", 4*time.Second)
+	}
+	m.writeUIConfig()
+	m.refreshSettingsItems()
+}
+
+func (m *model) promptConfirmCommands() {
+	m.openInput("Commands requiring confirmation (comma separated, empty for none)", strings.Join(m.confirmCommands, ", "), inputSettingsConfirmCommands)
+}
+
+func (m *model) setConfirmCommands(keys []string) {
+	m.confirmCommands = keys
+	m.emitSettingsChanged("confirm_commands", strings.Join(keys, ","))
+	if len(keys) == 0 {
+		m.setToast("Confirmations disabled", 4*time.Second)
+	} else {
+		m.setToast("Confirmations: "+strings.Join(keys, ", "), 4*time.Second)
+	}
+	m.writeUIConfig()
+	m.refreshSettingsItems()
+}
+
+// commandNeedsConfirm reports whether an item key or gpt-creator command is on
+// the confirmation list.
+func (m *model) commandNeedsConfirm(itemKey string, command []string) bool {
+	commandKey := confirmCommandKey(command)
+	for _, key := range m.confirmCommands {
+		if key == itemKey || key == commandKey {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *model) requestCommandConfirm(title string, args []string, run func() tea.Cmd) {
+	m.appendLog(fmt.Sprintf("Confirm %s: gpt-creator %s", title, strings.Join(args, " ")))
+	if m.inputActive && m.inputMode == inputCommandConfirm && m.pendingConfirmRun != nil {
+		// Multi-select runs share a single prompt.
+		prev := m.pendingConfirmRun
+		m.pendingConfirmRun = func() tea.Cmd { return tea.Batch(prev(), run()) }
+		m.pendingConfirmTitle += ", " + title
+	} else {
+		m.pendingConfirmRun = run
+		m.pendingConfirmTitle = title
+	}
+	m.openInput(fmt.Sprintf("Run %s? (type yes to continue)", m.pendingConfirmTitle), "", inputCommandConfirm)
+}
+
+var defaultConfirmCommands = []string{"run-down", "create-project"}
+
+// confirmCommandKey joins the leading subcommand words of a gpt-creator
+// invocation, so ["run", "down"] becomes "run-down". Flags and paths end it.
+func confirmCommandKey(command []string) string {
+	var parts []string
+	for _, arg := range command {
+		if strings.HasPrefix(arg, "-") || strings.ContainsRune(arg, filepath.Separator) || len(parts) == 2 {
+			break
+		}
+		parts = append(parts, arg)
+	}
+	return strings.Join(parts, "-")
+}
+
+func parseConfirmCommands(raw string) []string {
+	seen := make(map[string]bool)
+	keys := []string{}
+	for _, field := range strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || unicode.IsSpace(r) }) {
+		key := strings.ToLower(strings.TrimSpace(field))
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (m *model) settingsAutoWatchInfo() (string, string) {
+	desc := "Auto watch: Off"
+	if m.settingsAutoWatch {
+		desc = "Auto watch: On"
+	}
+	var b strings.Builder
+	b.WriteString("Auto watch\n──────────\n")
+	if m.settingsAutoWatch && m.rootWatcher != nil {
+		b.WriteString(fmt.Sprintf("Watching %s.\n", abbreviatePath(m.rootWatcher.root)))
+	} else if m.settingsAutoWatch {
+		b.WriteString("Enabled; waiting for a workspace root.\n")
+	} else {
+		b.WriteString("Projects are rescanned only when the root changes.\n")
+	}
+	b.WriteString("When on, directories created or removed under the current root\ntrigger a project rescan. Watching very large trees can be expensive.\n")
+	b.WriteString("The previewed artifact file is also re-rendered when it is rewritten.\n")
+	b.WriteString("\nEnter toggle\n")
+	return desc, b.String()
+}
+
+func (m *model) setAutoWatchSetting(enabled bool) {
+	if enabled == m.settingsAutoWatch {
+		return
+	}
+	m.settingsAutoWatch = enabled
+	m.syncRootWatcher()
+	m.syncArtifactWatcher()
+	m.emitSettingsChanged("auto_watch", ternary(enabled, "on", "off"))
+	m.setToast(ternary(enabled, "Watching workspace root for new projects", "Workspace watching disabled"), 4*time.Second)
+	m.writeUIConfig()
+	m.refreshSettingsItems()
+}
+
+func (m *model) settingsAutoVerifyInfo() (string, string) {
+	desc := "Auto verify: Off"
+	if m.settingsAutoVerify {
+		desc = "Auto verify: On"
+	}
+	var b strings.Builder
+	b.WriteString("Auto verify\n───────────\n")
+	if m.settingsAutoVerify {
+		b.WriteString("verify all is queued after every successful generate job.\n")
+	} else {
+		b.WriteString("Generate jobs finish without running verification.\n")
+	}
+	b.WriteString("The follow-up run uses the same Docker, dry-run and confirmation\nrules as starting verify all by hand, and only runs while the\ngenerated project is still selected.\n")
+	b.WriteString("\nEnter toggle\n")
+	return desc, b.String()
+}
+
+func (m *model) setAutoVerifySetting(enabled bool) {
+	if enabled == m.settingsAutoVerify {
+		return
+	}
+	m.settingsAutoVerify = enabled
+	m.emitSettingsChanged("auto_verify", ternary(enabled, "on", "off"))
+	m.setToast(ternary(enabled, "verify all will run after generate jobs", "Auto verify disabled"), 4*time.Second)
+	m.writeUIConfig()
+	m.refreshSettingsItems()
+}
+
+func (m *model) settingsInlineImagesInfo() (string, string) {
+	desc := "Inline images: Off"
+	if m.settingsInlineImages {
+		desc = "Inline images: On"
+	}
+	var b strings.Builder
+	b.WriteString("Inline images\n─────────────\n")
+	if m.settingsInlineImages {
+		b.WriteString("PNG and JPEG artifacts are drawn in the preview when the terminal\nsupports it.\n")
+	} else {
+		b.WriteString("Image artifacts show the usual binary-file placeholder.\n")
+	}
+	fmt.Fprintf(&b, "\nDetected protocol: %s\n", m.imageProtocol)
+	b.WriteString("Images need the kitty graphics protocol (kitty, Ghostty, WezTerm).\niTerm2 cannot keep an image inside a redrawn full-screen view, and\nSVG files are not rasterized, so both fall back to the placeholder.\n")
+	b.WriteString("\nEnter toggle\n")
+	return desc, b.String()
+}
+
+func (m *model) setInlineImagesSetting(enabled bool) {
+	if enabled == m.settingsInlineImages {
+		return
+	}
+	m.settingsInlineImages = enabled
+	m.emitSettingsChanged("inline_images", ternary(enabled, "on", "off"))
+	m.setToast(ternary(enabled, "Inline image previews enabled", "Inline image previews disabled"), 4*time.Second)
+	m.writeUIConfig()
+	m.refreshSettingsItems()
+}
+
+func (m *model) settingsDenseListsInfo() (string, string) {
+	desc := "Dense lists: Off"
+	if m.settingsDenseLists {
+		desc = "Dense lists: On"
+	}
+	var b strings.Builder
+	b.WriteString("Dense lists\n───────────\n")
+	if m.settingsDenseLists {
+		b.WriteString("List columns show one line per item, with the description after\nthe title, so more items fit on screen.\n")
+	} else {
+		b.WriteString("List columns show each item's title and description on separate\nlines.\n")
+	}
+	b.WriteString("\nEnter toggle\n")
+	return desc, b.String()
+}
+
+func (m *model) setDenseListsSetting(enabled bool) {
+	if enabled == m.settingsDenseLists {
+		return
+	}
+	m.settingsDenseLists = enabled
+	m.applyDenseLists()
+	m.emitSettingsChanged("dense_lists", ternary(enabled, "on", "off"))
+	m.setToast(ternary(enabled, "Dense lists enabled", "Dense lists disabled"), 4*time.Second)
+	m.writeUIConfig()
+	m.refreshSettingsItems()
+}
+
+// applyDenseLists switches every list column between one- and two-line items.
+func (m *model) applyDenseLists() {
+	for _, col := range []*selectableColumn{m.workspaceCol, m.featureCol, m.artifactsCol, m.docTOCCol} {
+		if col != nil {
+			col.SetDense(m.settingsDenseLists)
+		}
+	}
+}
+
+// inlineImagePreview renders an image artifact for the preview, or returns
+// "" so the caller falls back to the normal placeholder.
+func (m *model) inlineImagePreview(abs string) string {
+	if !m.settingsInlineImages || m.imageProtocol != inlineImagesKitty || !isInlineImagePath(abs) {
+		return ""
+	}
+	cols := m.previewCol.view.Width - 2
+	id, err := kittyImageID(abs, cols)
+	if err != nil {
+		m.appendDebugLog("inline image %s: %v", abs, err)
+		return ""
+	}
+	img, ok := m.inlineImages[id]
+	if !ok {
+		img, err = prepareKittyImage(abs, id, cols)
+		if err != nil {
+			// Remember the failure so later renders skip the decode.
+			m.appendDebugLog("inline image %s: %v", abs, err)
+			img = kittyImage{id: id}
+		} else {
+			m.pendingImageTransmits = append(m.pendingImageTransmits, transmitInlineImage(id, img.transmit))
+			img.transmit = ""
+		}
+		m.inlineImages[id] = img
+	}
+	if img.placeholder == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s\n\n%s", abs, img.placeholder)
+}
+
+// flushImageTransmits appends the image uploads queued while rendering the
+// preview to the commands Update returns.
+func (m *model) flushImageTransmits(cmd tea.Cmd) tea.Cmd {
+	if len(m.pendingImageTransmits) == 0 {
+		return cmd
+	}
+	cmds := append([]tea.Cmd{cmd}, m.pendingImageTransmits...)
+	m.pendingImageTransmits = nil
+	return tea.Batch(cmds...)
 }
 
-func (m *model) setConcurrency(value int) tea.Cmd {
-	if value < 1 {
-		value = 1
+// runAutoVerify queues verify all for path after a generate job succeeded,
+// even when another project is selected by then. Docker, policy, dry-run and
+// confirmation checks apply as they do for runItemCommand.
+func (m *model) runAutoVerify(path string) tea.Cmd {
+	var verifyAll featureItemDefinition
+	for _, def := range featureItemsByKey["verify"] {
+		if def.Key == "verify-all" {
+			verifyAll = def
+			break
+		}
 	}
-	if value == m.settingsConcurrency {
+	if len(verifyAll.Command) == 0 {
 		return nil
 	}
-	m.settingsConcurrency = value
-	var cmd tea.Cmd
-	if m.jobRunner != nil {
-		cmd = m.jobRunner.SetMaxParallel(value)
+	if !loadCommandPolicy(path).allows(verifyAll.Key, verifyAll.Command) {
+		m.appendLog(fmt.Sprintf("Command blocked by %s: gpt-creator %s", abbreviatePath(commandPolicyPath(path)), strings.Join(verifyAll.Command, " ")))
+		m.setToast("Command not allowed in this project", 5*time.Second)
+		return nil
+	}
+	if !m.dockerAvailable {
+		m.appendLog(m.dockerUnavailableMessage() + "; install or start Docker Desktop to run this command.")
+		m.setToast("Docker required: "+m.dockerUnavailableMessage(), 5*time.Second)
+		return nil
+	}
+	name := filepath.Base(path)
+	if project := m.projectByPath(path); project != nil {
+		name = project.Name
+	}
+	args := append([]string{}, verifyAll.Command...)
+	args = append(args, "--project", path)
+	title := fmt.Sprintf("%s • %s", verifyAll.Title, name)
+	m.appendLog("Auto verify: queuing verify all")
+	if m.commandNeedsConfirm(verifyAll.Key, verifyAll.Command) {
+		m.requestCommandConfirm(title, args, func() tea.Cmd {
+			return m.queueItemCommand(verifyAll, title, path, args)
+		})
+		return nil
+	}
+	return m.queueItemCommand(verifyAll, title, path, args)
+}
+
+func (m *model) setDryRunSetting(enabled bool) {
+	if enabled == m.settingsDryRun {
+		return
 	}
+	m.settingsDryRun = enabled
+	m.emitSettingsChanged("dry_run", ternary(enabled, "on", "off"))
+	m.setToast(ternary(enabled, "Dry run enabled: commands will not execute", "Dry run disabled"), 4*time.Second)
 	m.writeUIConfig()
-	m.emitSettingsChanged("concurrency", strconv.Itoa(value))
-	m.setToast(fmt.Sprintf("Max background jobs: %d", value), 4*time.Second)
 	m.refreshSettingsItems()
-	return cmd
+}
+
+// dryRunCommand logs the command that would run and reports whether the
+// dry-run setting intercepted it. enqueueJob calls it for every gpt-creator
+// job, so no entry point can bypass it.
+func (m *model) dryRunCommand(title, dir string, args []string) bool {
+	if !m.settingsDryRun {
+		return false
+	}
+	commandLine := strings.TrimSpace("gpt-creator " + strings.Join(args, " "))
+	m.appendLog(fmt.Sprintf("Dry run: %s", title))
+	m.appendLog(fmt.Sprintf("Command: %s", commandLine))
+	if dir != "" {
+		m.appendLog(fmt.Sprintf("Directory: %s", dir))
+	}
+	m.showLogs = true
+	fields := map[string]string{"command": commandLine}
+	if dir != "" {
+		fields["path"] = filepath.Clean(dir)
+	}
+	m.emitTelemetry("command_dry_run", fields)
+	m.setToast("Dry run: command not executed", 4*time.Second)
+	return true
 }
 
 func (m *model) addCustomWorkspaceRoot(path string) bool {
@@ -9976,6 +14930,7 @@ func (m *model) setDockerPath(path string) {
 	}
 	m.settingsDockerPath = trimmed
 	m.dockerAvailable = dockerCLIAvailableWithPath(trimmed)
+	m.dockerUnavailableReason = ""
 	m.writeUIConfig()
 	m.emitSettingsChanged("docker_path", trimmed)
 	if trimmed == "" {
@@ -9992,6 +14947,7 @@ func (m *model) clearDockerPath() {
 	}
 	m.settingsDockerPath = ""
 	m.dockerAvailable = dockerCLIAvailableWithPath("")
+	m.dockerUnavailableReason = ""
 	m.writeUIConfig()
 	m.emitSettingsChanged("docker_path", "")
 	m.setToast("Docker path cleared", 4*time.Second)
@@ -10009,6 +14965,10 @@ func (m *model) emitSettingsChanged(setting, value string) {
 func (m *model) runUpdate(force bool) tea.Cmd {
 	title := "Update gpt-creator"
 	args := []string{"update"}
+	if !m.currentCommandPolicy().allows("settings-update", args) {
+		m.blockDeniedCommand("update")
+		return nil
+	}
 	if force {
 		title = "Force update"
 		args = append(args, "--force")
@@ -10061,7 +15021,11 @@ func (m *model) refreshTokensView(resetSelection bool) tea.Cmd {
 		}
 		option = tokensRangeOptions[m.tokensRangeIndex]
 	}
-	data, err := buildTokensView(m.tokensUsage, option, m.tokensGroup)
+	var contextWindows map[string]int
+	if m.uiConfig != nil {
+		contextWindows = m.uiConfig.ModelContext
+	}
+	data, err := buildTokensView(m.tokensUsage, option, m.tokensGroup, contextWindows)
 	if err != nil {
 		m.tokensViewData = tokensViewData{}
 		m.tokensCurrentRow = ""
@@ -10087,6 +15051,12 @@ func (m *model) refreshTokensView(resetSelection bool) tea.Cmd {
 			return func() tea.Msg { return tokensRowSelectedMsg{row: row} }
 		}
 	}
+	if resetSelection && m.restoreColumnCursor(m.tokensCol) {
+		if row, ok := m.tokensCol.SelectedRow(); ok {
+			m.tokensCurrentRow = row.Key
+			return func() tea.Msg { return tokensRowSelectedMsg{row: row} }
+		}
+	}
 	row := data.Rows[0]
 	m.tokensCurrentRow = row.Key
 	m.tokensCol.SelectKey(row.Key)
@@ -10159,6 +15129,9 @@ func (m *model) toggleTokensGroup() tea.Cmd {
 }
 
 func (m *model) exportTokensCSV() tea.Cmd {
+	if m.rejectInReadOnly() {
+		return nil
+	}
 	if m.currentProject == nil {
 		return nil
 	}
@@ -10241,6 +15214,10 @@ func (m *model) queueTasksCommand(command []string) tea.Cmd {
 		m.appendLog("Select a project before running backlog commands.")
 		return nil
 	}
+	if !m.currentCommandPolicy().allows("", command) {
+		m.blockDeniedCommand(strings.Join(command, " "))
+		return nil
+	}
 	args := append([]string{}, command...)
 	needsProject := true
 	for _, arg := range args {
@@ -10253,7 +15230,17 @@ func (m *model) queueTasksCommand(command []string) tea.Cmd {
 		args = append(args, "--project", m.currentProject.Path)
 	}
 	title := "gpt-creator " + strings.Join(command, " ")
-	m.appendLog(fmt.Sprintf("Queued %s", title))
+	if m.commandNeedsConfirm("", command) {
+		m.requestCommandConfirm(title, args, func() tea.Cmd {
+			return m.queueTasksJob(command, title, args)
+		})
+		return nil
+	}
+	return m.queueTasksJob(command, title, args)
+}
+
+func (m *model) queueTasksJob(command []string, title string, args []string) tea.Cmd {
+	m.appendLog(m.queuedLogLine(title))
 	m.appendLog(fmt.Sprintf("Command: %s", title))
 	m.showLogs = true
 	fields := map[string]string{"command": strings.Join(command, " ")}
@@ -10261,6 +15248,7 @@ func (m *model) queueTasksCommand(command []string) tea.Cmd {
 		fields["project"] = filepath.Clean(m.currentProject.Path)
 	}
 	m.emitTelemetry("command_queued", fields)
+	m.recordSessionCommand(m.currentProject.Path, args)
 
 	var env []string
 	if command[0] == "create-jira-tasks" && len(m.selectedEpics) > 0 {
@@ -10325,6 +15313,9 @@ func (m *model) renderStatus() string {
 	segments := []string{
 		m.styles.statusSeg.Render(fmt.Sprintf("%s: %s", focusTitle, focusValue)),
 	}
+	if m.settingsDryRun {
+		segments = append(segments, m.styles.statusSeg.Copy().Foreground(crushDebug).Bold(true).Render("DRY RUN"))
+	}
 	var hoverHint string
 	if hintColumn := m.hoverColumn; hintColumn >= 0 && hintColumn < len(m.columns) {
 		if provider, ok := m.columns[hintColumn].(interface{ ActivationHint() string }); ok {
@@ -10342,6 +15333,14 @@ func (m *model) renderStatus() string {
 	if m.currentProject != nil {
 		segments = append(segments, m.styles.statusSeg.Render("Project: "+m.currentProject.Name))
 	}
+	badgeStyle := m.styles.statusSeg
+	if len(m.credentialMissing) > 0 {
+		badgeStyle = badgeStyle.Copy().Foreground(crushDebug)
+	}
+	m.credentialBadgeStart = m.styles.statusBar.GetPaddingLeft() + lipgloss.Width(strings.Join(segments, "│")) + 1
+	badge := badgeStyle.Render(m.credentialBadge())
+	m.credentialBadgeEnd = m.credentialBadgeStart + lipgloss.Width(badge)
+	segments = append(segments, badge)
 	if m.spinnerActive {
 		spin := m.spinner.View()
 		if trimmed := strings.TrimSpace(m.spinnerMessage); trimmed != "" {
@@ -10352,7 +15351,11 @@ func (m *model) renderStatus() string {
 	if m.jobTimingActive && strings.TrimSpace(m.jobTimingTitle) != "" {
 		title := strings.TrimSpace(m.jobTimingTitle)
 		elapsed := m.jobStopwatch.Elapsed()
-		segments = append(segments, m.styles.statusSeg.Render(fmt.Sprintf("Job: %s %s", title, formatElapsed(elapsed))))
+		label := fmt.Sprintf("Job: %s %s", title, formatElapsed(elapsed))
+		if eta := m.jobETALabel(title, elapsed); eta != "" {
+			label += " (" + eta + ")"
+		}
+		segments = append(segments, m.styles.statusSeg.Render(label))
 	} else if !m.jobTimingActive && m.jobLastDuration > 0 {
 		segments = append(segments, m.styles.statusSeg.Render("Last job "+formatElapsed(m.jobLastDuration)))
 	}
@@ -10423,21 +15426,74 @@ func (m *model) resolvePath(input string) string {
 	return filepath.Clean(filepath.Join(cwd, path))
 }
 
+// commonProjectDirs are home-relative folders where projects often live.
+// Unlike ~/gpt-projects they are only offered when they already contain a
+// gpt-creator project.
+var commonProjectDirs = []string{"Projects", "code"}
+
 func defaultWorkspaceRoots() []workspaceRoot {
 	var roots []workspaceRoot
 	seen := make(map[string]struct{})
 
+	for _, path := range workspaceRootsFromEnv(os.Getenv("GC_WORKSPACE_ROOTS")) {
+		addRootIfExists(&roots, seen, path)
+	}
 	if home, err := os.UserHomeDir(); err == nil {
 		addRootIfExists(&roots, seen, filepath.Join(home, "gpt-projects"))
+		for _, name := range commonProjectDirs {
+			if path := filepath.Join(home, name); containsProjects(path) {
+				addRootIfExists(&roots, seen, path)
+			}
+		}
 	}
 
 	return roots
 }
 
+// workspaceRootsFromEnv splits a GC_WORKSPACE_ROOTS value on semicolons or
+// the platform list separator, expanding a leading ~.
+func workspaceRootsFromEnv(value string) []string {
+	var paths []string
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool {
+		return r == ';' || r == os.PathListSeparator
+	}) {
+		path := strings.TrimSpace(part)
+		if path == "" {
+			continue
+		}
+		if strings.HasPrefix(path, "~") {
+			if home, err := os.UserHomeDir(); err == nil {
+				path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+			}
+		}
+		paths = append(paths, filepath.Clean(path))
+	}
+	return paths
+}
+
+// containsProjects reports whether dir is, or directly holds, a gpt-creator
+// project.
+func containsProjects(dir string) bool {
+	if isProjectDir(dir) {
+		return true
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		if entry.IsDir() && isProjectDir(filepath.Join(dir, entry.Name())) {
+			return true
+		}
+	}
+	return false
+}
+
 func addRootIfExists(list *[]workspaceRoot, seen map[string]struct{}, path string) {
 	if path == "" {
 		return
 	}
+	path = filepath.Clean(path)
 	if _, ok := seen[path]; ok {
 		return
 	}
@@ -10555,6 +15611,28 @@ func (m *model) shouldRefreshProjectSnapshot(path string) bool {
 	return true
 }
 
+// reloadCurrentProject rescans the selected project immediately, ignoring the
+// refresh throttle, and reloads views that keep their own data.
+func (m *model) reloadCurrentProject() tea.Cmd {
+	if m.currentProject == nil {
+		m.setToast("Select a project first", 4*time.Second)
+		return nil
+	}
+	clean := filepath.Clean(m.currentProject.Path)
+	if m.lastProjectRefresh == nil {
+		m.lastProjectRefresh = make(map[string]time.Time)
+	}
+	m.lastProjectRefresh[clean] = time.Now()
+	m.refreshProjectSnapshot(clean)
+	var cmd tea.Cmd
+	if !m.inBaseLayout() && m.currentFeature != "" {
+		cmd, _ = m.restoreProjectFeature(projectFeatureState{feature: m.currentFeature})
+	}
+	m.appendLog("Reloaded project from disk: " + clean)
+	m.setToast("Project reloaded", 3*time.Second)
+	return cmd
+}
+
 func (m *model) refreshProjectSnapshot(path string) {
 	clean := filepath.Clean(path)
 	if clean == "" {
@@ -10587,6 +15665,7 @@ func (m *model) refreshProjectSnapshot(path string) {
 		}
 	}
 	m.recordPipelineTelemetry(updated.Path, updated.Stats)
+	m.annotateWorkspaceGitState()
 
 	if m.currentProject != nil && filepath.Clean(m.currentProject.Path) == clean {
 		if project := m.projectByPath(clean); project != nil {
@@ -10621,17 +15700,17 @@ func (m *model) refreshProjectSnapshot(path string) {
 	}
 }
 
-func (m *model) openProjectInEditor() {
+func (m *model) openProjectInEditor() tea.Cmd {
 	project := m.currentProject
 	if project == nil {
 		m.appendLog("Select a workspace to open in editor.")
-		return
+		return nil
 	}
-	commandLine, err := launchEditor(project.Path)
+	commandLine, editorCmd, err := launchEditor(project.Path)
 	if err != nil {
 		m.appendLog(fmt.Sprintf("Failed to launch editor: %v", err))
 		m.setToast("Failed to open editor", 5*time.Second)
-		return
+		return nil
 	}
 	m.appendLog("Opening editor: " + commandLine)
 	m.setToast("Opening in editor", 4*time.Second)
@@ -10640,30 +15719,31 @@ func (m *model) openProjectInEditor() {
 		"command": commandLine,
 	}
 	m.emitTelemetry("editor_opened", fields)
+	return editorCmd
 }
 
-func (m *model) openCurrentDocInEditor() {
+func (m *model) openCurrentDocInEditor() tea.Cmd {
 	if m.currentProject == nil {
 		m.appendLog("Select a project before opening documentation.")
-		return
+		return nil
 	}
 	rel := strings.TrimSpace(m.currentDocRelPath)
 	if rel == "" {
 		m.appendLog("No document selected to open.")
 		m.setToast("Select a document first", 4*time.Second)
-		return
+		return nil
 	}
 	abs := filepath.Join(m.currentProject.Path, rel)
 	if _, err := os.Stat(abs); err != nil {
 		m.appendLog(fmt.Sprintf("Document not found: %s", abs))
 		m.setToast("Document not found", 5*time.Second)
-		return
+		return nil
 	}
-	commandLine, err := launchEditor(abs)
+	commandLine, editorCmd, err := launchEditor(abs)
 	if err != nil {
 		m.appendLog(fmt.Sprintf("Failed to launch editor: %v", err))
 		m.setToast("Failed to open document", 5*time.Second)
-		return
+		return nil
 	}
 	m.appendLog("Opening document: " + commandLine)
 	m.setToast("Opening document in editor", 4*time.Second)
@@ -10676,18 +15756,48 @@ func (m *model) openCurrentDocInEditor() {
 		fields["doc_type"] = m.currentDocType
 	}
 	m.emitTelemetry("doc_opened", fields)
+	return editorCmd
+}
+
+// regenerateCurrentGenerateFile queues generation for the selected file. The
+// CLI has no per-file option, so the file's target is regenerated; the items
+// and diff refresh when the job succeeds.
+func (m *model) regenerateCurrentGenerateFile() tea.Cmd {
+	rel := strings.TrimSpace(m.currentGenerateFile)
+	target := strings.TrimSpace(m.currentGenerateTarget)
+	if rel == "" || target == "" || target == "all" {
+		m.setToast("Select a generated file first", 4*time.Second)
+		return nil
+	}
+	def := featureItemDefinition{
+		Key:             "generate-" + target,
+		Title:           "generate " + target,
+		Command:         []string{"generate", target},
+		ProjectRequired: true,
+	}
+	for _, candidate := range featureItemsForKey("generate") {
+		if candidate.Key == def.Key {
+			def = candidate
+			break
+		}
+	}
+	def.Title = fmt.Sprintf("%s (for %s)", def.Title, filepath.Base(filepath.FromSlash(rel)))
+	selected := m.currentItem
+	cmd := m.runItemCommand(def)
+	m.currentItem = selected
+	return cmd
 }
 
-func (m *model) openCurrentGenerateFileInEditor() {
+func (m *model) openCurrentGenerateFileInEditor() tea.Cmd {
 	if m.currentProject == nil {
 		m.appendLog("Select a project before opening files.")
-		return
+		return nil
 	}
 	rel := strings.TrimSpace(m.currentGenerateFile)
 	if rel == "" {
 		m.appendLog("Select a generated file first.")
 		m.setToast("Select a file first", 4*time.Second)
-		return
+		return nil
 	}
 	status := ""
 	if m.currentItem.Meta != nil {
@@ -10697,34 +15807,195 @@ func (m *model) openCurrentGenerateFileInEditor() {
 	if status == "deleted" {
 		m.appendLog("File was deleted; cannot open in editor.")
 		m.setToast("File removed from workspace", 5*time.Second)
-		return
+		return nil
 	}
 	if _, err := os.Stat(abs); err != nil {
 		m.appendLog(fmt.Sprintf("File not found: %s", abs))
 		m.setToast("File not found", 5*time.Second)
-		return
+		return nil
 	}
-	commandLine, err := launchEditor(abs)
+	commandLine, editorCmd, err := launchEditor(abs)
 	if err != nil {
 		m.appendLog(fmt.Sprintf("Failed to launch editor: %v", err))
 		m.setToast("Failed to open file", 5*time.Second)
-		return
+		return nil
 	}
 	m.appendLog("Opening file: " + commandLine)
 	m.setToast("Opening file in editor", 4*time.Second)
+	m.recordRecentFile(abs)
 	fields := map[string]string{
 		"path":   filepath.Clean(m.currentProject.Path),
 		"file":   rel,
 		"target": strings.TrimSpace(m.currentGenerateTarget),
 	}
 	m.emitTelemetry("file_opened", fields)
+	return editorCmd
 }
 
-func (m *model) openDatabaseDumpInEditor(kind string) {
+const recentFilesLimit = 10
+
+// recordRecentFile remembers abs, relative to the current project, at the
+// front of that project's recent files.
+func (m *model) recordRecentFile(abs string) {
 	if m.currentProject == nil {
-		m.appendLog("Select a project before opening database dumps.")
 		return
 	}
+	projectPath := filepath.Clean(m.currentProject.Path)
+	rel, err := filepath.Rel(projectPath, abs)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return
+	}
+	rel = filepath.ToSlash(rel)
+	recent := []string{rel}
+	for _, existing := range m.recentFiles[projectPath] {
+		if existing != rel && len(recent) < recentFilesLimit {
+			recent = append(recent, existing)
+		}
+	}
+	if m.recentFiles == nil {
+		m.recentFiles = make(map[string][]string)
+	}
+	m.recentFiles[projectPath] = recent
+	m.writeUIConfig()
+}
+
+// openRecentFiles lists the current project's recently opened files in the
+// palette, dropping any that no longer exist.
+func (m *model) openRecentFiles() {
+	if m.currentProject == nil {
+		m.setToast("Select a project first", 4*time.Second)
+		return
+	}
+	projectPath := filepath.Clean(m.currentProject.Path)
+	var kept []string
+	var entries []paletteEntry
+	for _, rel := range m.recentFiles[projectPath] {
+		info, err := os.Stat(filepath.Join(projectPath, filepath.FromSlash(rel)))
+		if err != nil || info.IsDir() {
+			continue
+		}
+		kept = append(kept, rel)
+		entries = append(entries, paletteEntry{
+			label:       rel,
+			description: "modified " + formatRelativeTime(info.ModTime()),
+			meta:        map[string]string{"path": rel},
+		})
+	}
+	if len(kept) != len(m.recentFiles[projectPath]) {
+		if len(kept) == 0 {
+			delete(m.recentFiles, projectPath)
+		} else {
+			m.recentFiles[projectPath] = kept
+		}
+		m.writeUIConfig()
+	}
+	if len(entries) == 0 {
+		m.setToast("No recent files for this project", 4*time.Second)
+		return
+	}
+	m.recentFileEntries = entries
+	m.openInput("Recent files", "", inputRecentFiles)
+	m.paletteIndex = 0
+	m.updatePaletteMatches("")
+}
+
+// openFeatureVisibility lists the features that can be hidden, each toggled
+// in place with enter.
+func (m *model) openFeatureVisibility() {
+	m.featureVisibilityEntries = m.buildFeatureVisibilityEntries()
+	m.openInput("Features in the feature column", "", inputSettingsFeatures)
+	m.paletteIndex = 0
+	m.updatePaletteMatches("")
+}
+
+func (m *model) buildFeatureVisibilityEntries() []paletteEntry {
+	var entries []paletteEntry
+	for _, def := range featureDefinitions {
+		if !featureCanHide(def.Key) {
+			continue
+		}
+		state := "✓ shown"
+		if m.hiddenFeatures[def.Key] {
+			state = "✗ hidden"
+		}
+		entries = append(entries, paletteEntry{
+			label:       def.Title,
+			description: state + " • " + def.Desc,
+			meta:        map[string]string{"feature": def.Key},
+		})
+	}
+	return entries
+}
+
+func (m *model) toggleSelectedFeatureVisibility() {
+	entry, ok := m.selectedPaletteEntry()
+	if !ok || entry.meta == nil {
+		return
+	}
+	key := entry.meta["feature"]
+	if m.hiddenFeatures == nil {
+		m.hiddenFeatures = make(map[string]bool)
+	}
+	if m.hiddenFeatures[key] {
+		delete(m.hiddenFeatures, key)
+		m.setToast(entry.label+" shown", 3*time.Second)
+	} else {
+		m.hiddenFeatures[key] = true
+		m.setToast(entry.label+" hidden", 3*time.Second)
+	}
+	m.applyFeatureVisibility()
+	index := m.paletteIndex
+	m.featureVisibilityEntries = m.buildFeatureVisibilityEntries()
+	m.updatePaletteMatches(m.inputField.Value())
+	m.paletteIndex = min(index, max(len(m.paletteMatches)-1, 0))
+}
+
+func (m *model) showAllFeatures() {
+	if len(m.hiddenFeatures) == 0 {
+		m.setToast("All features are shown", 3*time.Second)
+		return
+	}
+	m.hiddenFeatures = make(map[string]bool)
+	m.applyFeatureVisibility()
+	m.setToast("All features shown", 3*time.Second)
+}
+
+func (m *model) applyFeatureVisibility() {
+	var keys []string
+	for _, def := range featureDefinitions {
+		if m.hiddenFeatures[def.Key] {
+			keys = append(keys, def.Key)
+		}
+	}
+	m.emitSettingsChanged("hidden_features", strings.Join(keys, ","))
+	m.populateFeatureList()
+	m.writeUIConfig()
+	m.refreshSettingsItems()
+}
+
+func (m *model) openSelectedRecentFile() tea.Cmd {
+	entry, ok := m.selectedPaletteEntry()
+	if !ok || entry.meta == nil || m.currentProject == nil {
+		m.setToast("No matching file", 4*time.Second)
+		return nil
+	}
+	abs := filepath.Join(m.currentProject.Path, filepath.FromSlash(entry.meta["path"]))
+	commandLine, editorCmd, err := launchEditor(abs)
+	if err != nil {
+		m.appendLog(fmt.Sprintf("Failed to launch editor: %v", err))
+		m.setToast("Failed to open file", 5*time.Second)
+		return nil
+	}
+	m.appendLog("Opening file: " + commandLine)
+	m.recordRecentFile(abs)
+	return editorCmd
+}
+
+func (m *model) openDatabaseDumpInEditor(kind string) tea.Cmd {
+	if m.currentProject == nil {
+		m.appendLog("Select a project before opening database dumps.")
+		return nil
+	}
 	var (
 		path  string
 		label string
@@ -10737,23 +16008,23 @@ func (m *model) openDatabaseDumpInEditor(kind string) {
 		path = strings.TrimSpace(m.currentDBSeedPath)
 		label = "seed.sql"
 	default:
-		return
+		return nil
 	}
 	if path == "" {
 		m.appendLog(fmt.Sprintf("No %s available to open.", label))
 		m.setToast(fmt.Sprintf("No %s found", label), 4*time.Second)
-		return
+		return nil
 	}
 	if _, err := os.Stat(path); err != nil {
 		m.appendLog(fmt.Sprintf("%s not found: %v", label, err))
 		m.setToast(fmt.Sprintf("%s missing", label), 5*time.Second)
-		return
+		return nil
 	}
-	commandLine, err := launchEditor(path)
+	commandLine, editorCmd, err := launchEditor(path)
 	if err != nil {
 		m.appendLog(fmt.Sprintf("Failed to open %s: %v", label, err))
 		m.setToast(fmt.Sprintf("Failed to open %s", label), 5*time.Second)
-		return
+		return nil
 	}
 	m.appendLog(fmt.Sprintf("Opening %s: %s", label, commandLine))
 	m.setToast(fmt.Sprintf("Opening %s", label), 4*time.Second)
@@ -10768,6 +16039,7 @@ func (m *model) openDatabaseDumpInEditor(kind string) {
 		"kind": kind,
 	}
 	m.emitTelemetry("db_dump_opened", fields)
+	return editorCmd
 }
 
 func (m *model) selectedReportEntry() (reportEntry, bool) {
@@ -10777,16 +16049,19 @@ func (m *model) selectedReportEntry() (reportEntry, bool) {
 	return m.reportsCol.SelectedEntry()
 }
 
-func (m *model) openSelectedReport() {
+func (m *model) openSelectedReport() tea.Cmd {
 	entry, ok := m.selectedReportEntry()
 	if !ok {
 		m.setToast("Select a report first", 4*time.Second)
-		return
+		return nil
 	}
-	m.openReportEntry(entry)
+	return m.openReportEntry(entry)
 }
 
 func (m *model) exportSelectedReport() tea.Cmd {
+	if m.rejectInReadOnly() {
+		return nil
+	}
 	entry, ok := m.selectedReportEntry()
 	if !ok {
 		m.setToast("Select a report first", 4*time.Second)
@@ -11001,34 +16276,35 @@ func reportOpenMode(format string) string {
 	}
 }
 
-func (m *model) openReportEntry(entry reportEntry) {
+func (m *model) openReportEntry(entry reportEntry) tea.Cmd {
 	if m.currentProject == nil {
 		m.setToast("Select a project first", 4*time.Second)
-		return
+		return nil
 	}
 	if strings.TrimSpace(entry.AbsPath) == "" {
 		m.setToast("Report path unavailable", 4*time.Second)
-		return
+		return nil
 	}
 	if _, err := os.Stat(entry.AbsPath); err != nil {
 		m.appendLog(fmt.Sprintf("Report not found: %s", entry.AbsPath))
 		m.setToast("Report missing", 5*time.Second)
-		return
+		return nil
 	}
 	mode := reportOpenMode(entry.Format)
 	var (
 		commandLine string
 		err         error
+		editorCmd   tea.Cmd
 	)
 	if mode == "browser" {
 		commandLine, err = launchBrowser(entry.AbsPath)
 	} else {
-		commandLine, err = launchEditor(entry.AbsPath)
+		commandLine, editorCmd, err = launchEditor(entry.AbsPath)
 	}
 	if err != nil {
 		m.appendLog(fmt.Sprintf("Failed to open report %s: %v", entry.RelPath, err))
 		m.setToast("Failed to open report", 5*time.Second)
-		return
+		return nil
 	}
 	if mode == "browser" {
 		m.appendLog("Opening report in browser: " + commandLine)
@@ -11053,6 +16329,7 @@ func (m *model) openReportEntry(entry reportEntry) {
 		}
 		m.emitTelemetry("report_opened", fields)
 	}
+	return editorCmd
 }
 
 func launchBrowser(target string) (string, error) {
@@ -11094,7 +16371,10 @@ func launchBrowser(target string) (string, error) {
 	}
 }
 
-func launchEditor(path string) (string, error) {
+// launchEditor opens path in $VISUAL, $EDITOR or the OS opener. Terminal
+// editors cannot run detached, so for those it returns a command that
+// suspends the TUI and runs the editor in the foreground instead.
+func launchEditor(path string) (string, tea.Cmd, error) {
 	candidates := []string{os.Getenv("VISUAL"), os.Getenv("EDITOR")}
 	for _, candidate := range candidates {
 		candidate = strings.TrimSpace(candidate)
@@ -11105,32 +16385,81 @@ func launchEditor(path string) (string, error) {
 		parts = append(parts, path)
 		bin := parts[0]
 		args := parts[1:]
+		commandLine := strings.Join(append([]string{bin}, args...), " ")
+		if isTerminalEditor(parts[:len(parts)-1]) {
+			if _, err := exec.LookPath(bin); err != nil {
+				continue
+			}
+			cmd := exec.Command(bin, args...)
+			return commandLine, tea.ExecProcess(cmd, func(err error) tea.Msg {
+				return editorExitedMsg{command: commandLine, err: err}
+			}), nil
+		}
 		cmd := exec.Command(bin, args...)
 		if err := cmd.Start(); err != nil {
 			continue
 		}
-		return strings.Join(append([]string{bin}, args...), " "), nil
+		return commandLine, nil, nil
 	}
 	switch runtime.GOOS {
 	case "darwin":
 		cmd := exec.Command("open", path)
 		if err := cmd.Start(); err != nil {
-			return "", err
+			return "", nil, err
 		}
-		return "open " + path, nil
+		return "open " + path, nil, nil
 	case "windows":
 		quoted := fmt.Sprintf("\"%s\"", path)
 		cmd := exec.Command("cmd", "/c", "start", "", quoted)
 		if err := cmd.Start(); err != nil {
-			return "", err
+			return "", nil, err
 		}
-		return "cmd /c start " + quoted, nil
+		return "cmd /c start " + quoted, nil, nil
 	default:
 		cmd := exec.Command("xdg-open", path)
 		if err := cmd.Start(); err != nil {
-			return "", err
+			return "", nil, err
+		}
+		return "xdg-open " + path, nil, nil
+	}
+}
+
+var terminalEditors = map[string]bool{
+	"vi": true, "vim": true, "nvim": true, "view": true, "nano": true, "pico": true,
+	"micro": true, "hx": true, "helix": true, "kak": true, "joe": true, "ne": true,
+	"mg": true, "jed": true, "ed": true,
+}
+
+// isTerminalEditor reports whether an editor command line (without the file
+// argument) runs inside the terminal rather than opening its own window.
+func isTerminalEditor(parts []string) bool {
+	if len(parts) == 0 {
+		return false
+	}
+	name := strings.TrimSuffix(strings.ToLower(filepath.Base(parts[0])), ".exe")
+	if terminalEditors[name] {
+		return true
+	}
+	if name == "emacs" || name == "emacsclient" {
+		for _, arg := range parts[1:] {
+			switch arg {
+			case "-nw", "--no-window-system", "-t", "--tty":
+				return true
+			}
 		}
-		return "xdg-open " + path, nil
+	}
+	return false
+}
+
+func (m *model) handleEditorExited(msg editorExitedMsg) {
+	if msg.err != nil {
+		m.appendLog(fmt.Sprintf("Editor exited with error (%s): %v", msg.command, msg.err))
+		m.setToast("Editor exited with an error", 5*time.Second)
+	} else {
+		m.appendLog("Editor closed: " + msg.command)
+	}
+	if m.currentProject != nil {
+		m.refreshCurrentFeatureItemsFor(m.currentProject.Path)
 	}
 }
 
@@ -11332,12 +16661,27 @@ func formatProjectDescription(stats projectStats) string {
 	if stats.VerifyTotal > 0 {
 		verify = fmt.Sprintf("Verify %d/%d", stats.VerifyPass, stats.VerifyTotal)
 	}
+	if stats.GitBranch != "" {
+		return fmt.Sprintf("%s · %s · %s · %s", stage, tasks, verify, formatGitState(stats))
+	}
 	return fmt.Sprintf("%s · %s · %s", stage, tasks, verify)
 }
 
-func featureListEntries() []list.Item {
+// formatGitState renders the branch with a trailing "*" for a dirty tree.
+func formatGitState(stats projectStats) string {
+	if stats.GitDirty {
+		return "⎇ " + stats.GitBranch + "*"
+	}
+	return "⎇ " + stats.GitBranch
+}
+
+// featureListEntries lists the feature column, leaving out hidden features.
+func featureListEntries(hidden map[string]bool) []list.Item {
 	items := make([]list.Item, 0, len(featureDefinitions))
 	for _, def := range featureDefinitions {
+		if hidden[def.Key] && featureCanHide(def.Key) {
+			continue
+		}
 		items = append(items, listEntry{
 			title:   def.Title,
 			desc:    def.Desc,
@@ -11347,6 +16691,23 @@ func featureListEntries() []list.Item {
 	return items
 }
 
+// featureCanHide reports whether key may be hidden from the feature column.
+// Overview is the landing view and Settings is where features are shown
+// again, so both always stay.
+func featureCanHide(key string) bool {
+	return key != "overview" && key != "settings"
+}
+
+func hiddenFeatureSet(keys []string) map[string]bool {
+	hidden := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if featureCanHide(key) {
+			hidden[key] = true
+		}
+	}
+	return hidden
+}
+
 func findFeatureDefinition(key string) featureDefinition {
 	for _, def := range featureDefinitions {
 		if def.Key == key {
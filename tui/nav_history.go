@@ -0,0 +1,61 @@
+package main
+
+// maxNavHistory caps the number of locations kept for back/forward.
+const maxNavHistory = 50
+
+// navLocation is a point in the column hierarchy that back/forward can
+// return to. itemKey follows the cursor within a location rather than
+// creating new entries, so scrolling through items is not a jump.
+type navLocation struct {
+	root    string
+	project string
+	feature string
+	itemKey string
+	focus   int
+}
+
+func (l navLocation) sameJump(other navLocation) bool {
+	return l.root == other.root && l.project == other.project && l.feature == other.feature && l.focus == other.focus
+}
+
+// navHistory is a vim-style jump list: visiting a new location drops any
+// forward entries, and the oldest entries fall off past maxNavHistory.
+type navHistory struct {
+	entries []navLocation
+	index   int
+}
+
+// record notes loc as the current location.
+func (h *navHistory) record(loc navLocation) {
+	if len(h.entries) > 0 {
+		if h.entries[h.index].sameJump(loc) {
+			h.entries[h.index] = loc
+			return
+		}
+		h.entries = h.entries[:h.index+1]
+	}
+	h.entries = append(h.entries, loc)
+	if len(h.entries) > maxNavHistory {
+		h.entries = h.entries[len(h.entries)-maxNavHistory:]
+	}
+	h.index = len(h.entries) - 1
+}
+
+// step moves delta entries back (negative) or forward and returns the
+// location there.
+func (h *navHistory) step(delta int) (navLocation, bool) {
+	target := h.index + delta
+	if len(h.entries) == 0 || target < 0 || target >= len(h.entries) {
+		return navLocation{}, false
+	}
+	h.index = target
+	return h.entries[target], true
+}
+
+// replace overwrites the current entry, used after replaying a location that
+// could only be partly restored.
+func (h *navHistory) replace(loc navLocation) {
+	if len(h.entries) > 0 {
+		h.entries[h.index] = loc
+	}
+}
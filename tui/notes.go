@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// notesRelPath is where the notes feature keeps a project's scratch notes.
+const notesRelPath = ".gpt-creator/notes.md"
+
+func projectNotesPath(project *discoveredProject) string {
+	return filepath.Join(project.Path, filepath.FromSlash(notesRelPath))
+}
+
+// readProjectNotes returns the project's notes, or "" when none were written.
+func readProjectNotes(project *discoveredProject) (string, error) {
+	data, err := os.ReadFile(projectNotesPath(project))
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	return string(data), err
+}
+
+// writeProjectNotes saves content and reports whether the file changed.
+// Clearing notes that were never saved does not create the file.
+func writeProjectNotes(project *discoveredProject, content string) (bool, error) {
+	existing, err := readProjectNotes(project)
+	if err != nil {
+		return false, err
+	}
+	if content == existing {
+		return false, nil
+	}
+	path := projectNotesPath(project)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return false, err
+	}
+	return true, os.WriteFile(path, []byte(content), 0o644)
+}
+
+func renderNotesPreview(project *discoveredProject) string {
+	if project == nil {
+		return "Select a project to see its notes.\n"
+	}
+	notes, err := readProjectNotes(project)
+	if err != nil {
+		return "Unable to read " + notesRelPath + ": " + err.Error() + "\n"
+	}
+	if strings.TrimSpace(notes) == "" {
+		return "# Notes\n\n_No notes yet._ Press Enter to start writing; they are saved to `" + notesRelPath + "`.\n"
+	}
+	return notes
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// buildOverviewBrief assembles a shareable Markdown summary of a project from
+// the same renderers the overview, backlog, verify and tokens views use.
+func buildOverviewBrief(project *discoveredProject, backlog *backlogData, usage *tokensUsage, now time.Time) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("# %s overview\n\n", project.Name))
+	b.WriteString(fmt.Sprintf("- Path: `%s`\n", project.Path))
+	b.WriteString(fmt.Sprintf("- Generated: %s\n\n", now.Format(time.RFC1123)))
+
+	b.WriteString("## Pipeline\n\n")
+	b.WriteString(overviewBriefBlock(renderPipeline(project)))
+	for _, step := range project.Stats.Pipeline {
+		line := fmt.Sprintf("- %s %s: %s", pipelineStateGlyph(step.State), step.Label, pipelineStateLabel(step.State))
+		if !step.LastUpdated.IsZero() {
+			line += fmt.Sprintf(" (updated %s)", step.LastUpdated.Format("2006-01-02 15:04"))
+		}
+		b.WriteString(line + "\n")
+	}
+
+	b.WriteString("\n## Backlog\n\n")
+	if backlog == nil {
+		b.WriteString("Backlog unavailable.\n")
+	} else {
+		b.WriteString(overviewBriefBlock(strings.Join(renderBacklogSummaryLines(backlog.Summary), "\n")))
+	}
+
+	b.WriteString("\n## Verify\n\n")
+	b.WriteString(overviewBriefBlock(renderVerifyDashboard(project)))
+
+	b.WriteString("\n## Tokens\n\n")
+	data, _ := buildTokensView(usage, tokensRangeOptions[len(tokensRangeOptions)-1], tokensGroupByCommand, nil)
+	if data.Summary.Records == 0 {
+		b.WriteString("No token usage recorded.\n")
+	} else {
+		b.WriteString(tokensContextString(data) + "\n\n")
+		b.WriteString("| Command | Calls | Tokens | Est. cost |\n|---|---:|---:|---:|\n")
+		for _, row := range data.Rows {
+			b.WriteString(fmt.Sprintf("| %s | %d | %s | %s |\n", row.Label, row.Calls, formatIntComma(row.Tokens), formatCost(row.Cost)))
+		}
+	}
+	return b.String()
+}
+
+// overviewBriefBlock strips styling from a rendered summary and fences it so
+// bars and alignment survive Markdown rendering.
+func overviewBriefBlock(rendered string) string {
+	lines := strings.Split(strings.TrimRight(rendered, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(stripANSI(line), " ")
+	}
+	return "```\n" + strings.Join(lines, "\n") + "\n```\n"
+}
+
+func writeOverviewBrief(projectPath, content string, now time.Time) (string, error) {
+	dir := filepath.Join(projectPath, "reports")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("overview-%s.md", now.UTC().Format("20060102-150405")))
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
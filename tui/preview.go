@@ -10,6 +10,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/charmbracelet/bubbles/key"
 )
 
 const (
@@ -169,7 +171,9 @@ func renderGenerateTargetDetail(project *discoveredProject, item featureItemDefi
 		}
 		return b.String()
 	}
-	b.WriteString(fmt.Sprintf("Files changed: %d (%s)\n\n", counts.Total(), counts.Summary()))
+	b.WriteString(fmt.Sprintf("Files changed: %d (%s)\n", counts.Total(), counts.Summary()))
+	added, removed := generateLineStats(project.Path, entry.Files)
+	b.WriteString(fmt.Sprintf("Lines: %s+%d%s / %s-%d%s\n\n", ansiGreen, added, ansiReset, ansiRed, removed, ansiReset))
 	for _, change := range entry.Files {
 		status := change.StatusLabel
 		if strings.TrimSpace(status) == "" {
@@ -189,11 +193,12 @@ func renderGenerateTargetDetail(project *discoveredProject, item featureItemDefi
 
 func renderGenerateDiff(project *discoveredProject, item featureItemDefinition) string {
 	source := strings.TrimSpace(item.Meta["generateDiffSource"])
+	hint := "\n\nPress `K` to keep this file or `X` to revert it to the base.\n"
 	switch source {
 	case generateDiffSourceGit:
-		return renderGenerateGitDiff(project, item)
+		return strings.TrimRight(renderGenerateGitDiff(project, item), "\n") + hint
 	case generateDiffSourceSnapshot:
-		return renderGenerateSnapshotDiff(project, item)
+		return strings.TrimRight(renderGenerateSnapshotDiff(project, item), "\n") + hint
 	default:
 		return "Diff source unavailable.\n"
 	}
@@ -309,6 +314,51 @@ func previewDocFile(project *discoveredProject, rel string) string {
 	return header + "\n" + rendered
 }
 
+// renderDocRevisionDiff diffs the working copy of rel against rev when the
+// project is a git repository, or against the latest generate snapshot
+// otherwise. The returned source is "git" or "snapshot".
+func renderDocRevisionDiff(projectPath, rel, rev string) (string, string, error) {
+	abs := filepath.Join(projectPath, rel)
+	if info, err := os.Stat(abs); err != nil || info.IsDir() {
+		return "", "", fmt.Errorf("document not found: %s", abs)
+	}
+	var base, baseLabel, source string
+	if projectHasGitRepo(projectPath) {
+		content, err := gitFileAtRevision(projectPath, rev, rel)
+		if err != nil {
+			return "", "", err
+		}
+		base, baseLabel, source = content, "git "+rev, generateDiffSourceGit
+	} else {
+		record, ok := snapshotForProject(filepath.Clean(projectPath))
+		if !ok {
+			return "", "", fmt.Errorf("not a git repository and no generate snapshot is available")
+		}
+		found := ""
+		for _, dir := range record.TargetDirs {
+			candidate := filepath.Join(dir, rel)
+			if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+				found = candidate
+				break
+			}
+		}
+		if found == "" {
+			return "", "", fmt.Errorf("no snapshot copy of %s", rel)
+		}
+		base = readFileForDiff(found)
+		baseLabel = fmt.Sprintf("snapshot %s", record.Created.Local().Format(time.RFC822))
+		source = generateDiffSourceSnapshot
+	}
+	head := readFileForDiff(abs)
+	header := fmt.Sprintf("Diff • %s\nBase: %s\n", rel, baseLabel)
+	if head == base {
+		return header + "\nNo differences.\n", source, nil
+	}
+	chunks := diffLines(strings.Split(base, "\n"), strings.Split(head, "\n"))
+	diffText := limitLines(renderDiffChunks(chunks), maxDiffPreviewLines)
+	return header + "\n" + diffText, source, nil
+}
+
 func previewDocDiff(project *discoveredProject, docType string, meta map[string]string) string {
 	if project == nil {
 		return ""
@@ -358,6 +408,101 @@ func previewDocDiff(project *discoveredProject, docType string, meta map[string]
 	return header + "\n" + diffText
 }
 
+// renderVerifyDashboard rolls up the project's verify summary into totals,
+// a pass-rate bar and the most recent run.
+func renderVerifyDashboard(project *discoveredProject) string {
+	if project == nil {
+		return "Select a project to inspect verification results.\n"
+	}
+	summary := verifySummaryForProject(project)
+	stats := summary.Stats
+	overall := overallVerifyStatus(summary)
+	var b strings.Builder
+	header := fmt.Sprintf("%s Verify dashboard", verifyStatusIcon(overall))
+	b.WriteString(header + "\n")
+	b.WriteString(strings.Repeat("═", len(header)))
+	b.WriteString("\n")
+	if stats.Total == 0 {
+		b.WriteString("No verification runs recorded yet.\n")
+		b.WriteString("Run `gpt-creator verify all` to populate results.\n")
+		return b.String()
+	}
+	pending := stats.Total - stats.Passed - stats.Failed - stats.Skipped
+	if pending < 0 {
+		pending = 0
+	}
+	b.WriteString(fmt.Sprintf("Checks %d • Passed %d • Failed %d • Skipped %d", stats.Total, stats.Passed, stats.Failed, stats.Skipped))
+	if pending > 0 {
+		b.WriteString(fmt.Sprintf(" • Pending %d", pending))
+	}
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("Overall: %s\n", verifyStatusLabel(overall)))
+	percent := float64(stats.Passed) / float64(stats.Total)
+	b.WriteString(fmt.Sprintf("Pass rate %d/%d\n", stats.Passed, stats.Total))
+	b.WriteString(renderProgressBar(percent, 36))
+	b.WriteString("\n")
+	if !summary.LastUpdated.IsZero() {
+		ts := summary.LastUpdated.Local()
+		b.WriteString(fmt.Sprintf("Last run: %s (%s ago)", ts.Format(time.RFC822), formatRelativeTime(ts)))
+		if summary.LastRunKind != "" {
+			b.WriteString(" • verify " + summary.LastRunKind)
+		}
+		b.WriteString("\n")
+	}
+	if failing := failedVerifyChecks(summary); len(failing) > 0 {
+		b.WriteString("\nFailing checks:\n")
+		for _, check := range failing {
+			b.WriteString("  " + verifyStatusIcon("fail") + " " + check.Label + "\n")
+		}
+	}
+	return b.String()
+}
+
+// renderVerifySplitPreview shows a check's report and log next to each other.
+// When only one of them exists it is shown on its own.
+func renderVerifySplitPreview(project *discoveredProject, item featureItemDefinition) (string, bool) {
+	if project == nil || item.Meta == nil {
+		return "", false
+	}
+	readLines := func(rel string) (string, []string) {
+		rel = strings.TrimSpace(rel)
+		if rel == "" {
+			return "", nil
+		}
+		abs := filepath.Join(project.Path, filepath.FromSlash(rel))
+		content := readFileLimited(abs, maxDocPreviewBytes, maxDiffPreviewLines)
+		if content == "" {
+			return "", nil
+		}
+		if ext := strings.ToLower(filepath.Ext(abs)); ext == ".html" || ext == ".htm" {
+			content = stripHTMLTags(content)
+		}
+		return rel, strings.Split(strings.TrimRight(content, "\n"), "\n")
+	}
+	reportRel, reportLines := readLines(item.Meta["verifyReport"])
+	logRel, logLines := readLines(item.Meta["verifyLog"])
+	label := strings.TrimSpace(item.Meta["verifyLabel"])
+	if label == "" {
+		label = item.Title
+	}
+	var b strings.Builder
+	b.WriteString(label + " • report / log\n\n")
+	switch {
+	case reportLines != nil && logLines != nil:
+		b.WriteString(renderSideBySideFiles("Report: "+reportRel, "Log: "+logRel, reportLines, logLines))
+	case reportLines != nil:
+		b.WriteString("Report: " + reportRel + " (log unavailable)\n\n")
+		b.WriteString(strings.Join(reportLines, "\n"))
+	case logLines != nil:
+		b.WriteString("Log: " + logRel + " (report unavailable)\n\n")
+		b.WriteString(strings.Join(logLines, "\n"))
+	default:
+		return "", false
+	}
+	b.WriteString("\n\nPress `s` to exit split mode.\n")
+	return b.String(), true
+}
+
 func renderVerifyCheckDetail(project *discoveredProject, item featureItemDefinition) string {
 	if project == nil {
 		return "Select a project to inspect verification results.\n"
@@ -416,6 +561,9 @@ func renderVerifyCheckDetail(project *discoveredProject, item featureItemDefinit
 		reportAbs := filepath.Join(project.Path, filepath.FromSlash(reportRel))
 		b.WriteString("\nReport: " + reportAbs + "\n")
 	}
+	if logRel != "" || strings.TrimSpace(item.Meta["verifyReport"]) != "" {
+		b.WriteString("\nPress `s` to view the report and log side by side.\n")
+	}
 	return b.String()
 }
 
@@ -636,7 +784,7 @@ func renderServicePreview(meta map[string]string) string {
 	}
 
 	b.WriteByte('\n')
-	b.WriteString("Stack shortcuts: u=run up • l=run logs • d=run down • o=open endpoint\n")
+	b.WriteString("Stack shortcuts: u=run up • l=run logs • d=run down • o=open endpoint • a=open all\n")
 	return strings.TrimRight(b.String(), "\n")
 }
 
@@ -674,6 +822,17 @@ func firstExisting(dir string, names ...string) string {
 	return ""
 }
 
+// numberLines prefixes each line of content with its 1-based number, padded
+// to the width of the largest.
+func numberLines(content string) string {
+	lines := strings.Split(content, "\n")
+	digits := len(strconv.Itoa(len(lines)))
+	for i, line := range lines {
+		lines[i] = fmt.Sprintf("%*d │ %s", digits, i+1, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
 func readFileSnippet(path string) string {
 	return readFileLimited(path, maxPreviewBytes, maxPreviewLines)
 }
@@ -803,3 +962,18 @@ func limitLines(text string, maxLines int) string {
 	lines = append(lines[:maxLines], "… (truncated)")
 	return strings.Join(lines, "\n")
 }
+
+// renderFeatureKeyHelp lists bindings as an aligned "key  action" block.
+func renderFeatureKeyHelp(title string, bindings []key.Binding) string {
+	width := 0
+	for _, binding := range bindings {
+		width = max(width, len(binding.Help().Key))
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Keys in %s:\n", title)
+	for _, binding := range bindings {
+		help := binding.Help()
+		fmt.Fprintf(&b, "  %-*s  %s\n", width, help.Key, help.Desc)
+	}
+	return b.String()
+}
@@ -3,8 +3,11 @@ package main
 import (
 	"encoding/json"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -28,6 +31,9 @@ type projectStats struct {
 	VerifyTotal int
 
 	LastRun time.Time
+
+	GitBranch string
+	GitDirty  bool
 }
 
 type pipelineStep struct {
@@ -160,9 +166,120 @@ func collectProjectStats(path string) projectStats {
 	stats.TasksDone, stats.TasksTotal = gatherTaskMetrics(path)
 	stats.VerifyPass, stats.VerifyTotal = gatherVerifyMetrics(path)
 	stats.LastRun = latestProjectModTime(path)
+	stats.GitBranch, stats.GitDirty = gatherGitState(path)
 	return stats
 }
 
+// gitStateMaxAge bounds how long a cached git state is trusted: edits deep
+// in the work tree make it dirty without touching anything the cache key
+// stats.
+const gitStateMaxAge = 30 * time.Second
+
+type gitStateEntry struct {
+	key     string
+	checked time.Time
+	branch  string
+	dirty   bool
+}
+
+var (
+	gitStateMu    sync.Mutex
+	gitStateCache = make(map[string]gitStateEntry)
+)
+
+// gatherGitState reports the branch and dirty state of root when root is
+// itself the top level of a git work tree. Projects nested inside another
+// repository report nothing rather than the parent's state. Results are
+// cached by root and the modification times of its git metadata so project
+// discovery does not run git for every project on every refresh.
+func gatherGitState(root string) (branch string, dirty bool) {
+	root = filepath.Clean(root)
+	key, ok := gitStateKey(root)
+	if !ok {
+		return "", false
+	}
+	gitStateMu.Lock()
+	entry, cached := gitStateCache[root]
+	gitStateMu.Unlock()
+	if cached && entry.key == key && time.Since(entry.checked) < gitStateMaxAge {
+		return entry.branch, entry.dirty
+	}
+	branch, dirty = readGitState(root)
+	gitStateMu.Lock()
+	gitStateCache[root] = gitStateEntry{key: key, checked: time.Now(), branch: branch, dirty: dirty}
+	gitStateMu.Unlock()
+	return branch, dirty
+}
+
+// gitStateKey stats root/.git, which only exists at a work tree's top level,
+// and folds in the times that change on commits, checkouts and staging.
+func gitStateKey(root string) (string, bool) {
+	gitPath := filepath.Join(root, ".git")
+	info, err := os.Stat(gitPath)
+	if err != nil {
+		return "", false
+	}
+	parts := []string{info.ModTime().String()}
+	for _, name := range []string{"HEAD", "index"} {
+		if fi, err := os.Stat(filepath.Join(gitPath, name)); err == nil {
+			parts = append(parts, fi.ModTime().String())
+		}
+	}
+	if fi, err := os.Stat(root); err == nil {
+		parts = append(parts, fi.ModTime().String())
+	}
+	return strings.Join(parts, "|"), true
+}
+
+// readGitState reads the current branch and whether the work tree has
+// changes with a single porcelain status call, after confirming root is the
+// work tree's top level.
+func readGitState(root string) (branch string, dirty bool) {
+	top, err := exec.Command("git", "-C", root, "rev-parse", "--show-toplevel").Output()
+	if err != nil || !samePath(strings.TrimSpace(string(top)), root) {
+		return "", false
+	}
+	out, err := exec.Command("git", "-C", root, "status", "--porcelain", "--branch").Output()
+	if err != nil {
+		return "", false
+	}
+	lines := strings.Split(strings.TrimRight(string(out), "\n"), "\n")
+	if len(lines) == 0 || !strings.HasPrefix(lines[0], "## ") {
+		return "", false
+	}
+	header := strings.TrimPrefix(lines[0], "## ")
+	switch {
+	case strings.HasPrefix(header, "No commits yet on "):
+		branch = strings.TrimPrefix(header, "No commits yet on ")
+	case strings.HasPrefix(header, "HEAD (no branch)"):
+		branch = "detached"
+	default:
+		branch = header
+		if idx := strings.Index(branch, "..."); idx >= 0 {
+			branch = branch[:idx]
+		}
+		if idx := strings.IndexByte(branch, ' '); idx >= 0 {
+			branch = branch[:idx]
+		}
+	}
+	return branch, len(lines) > 1
+}
+
+// samePath reports whether a and b name the same directory, resolving
+// symlinks so /tmp and /private/tmp style aliases compare equal.
+func samePath(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	if resolved, err := filepath.EvalSymlinks(a); err == nil {
+		a = resolved
+	}
+	if resolved, err := filepath.EvalSymlinks(b); err == nil {
+		b = resolved
+	}
+	return filepath.Clean(a) == filepath.Clean(b)
+}
+
 func gatherTaskMetrics(root string) (done, total int) {
 	file := filepath.Join(root, ".gpt-creator", "staging", "plan", "tasks", "progress.json")
 	data, err := os.ReadFile(file)
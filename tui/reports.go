@@ -57,6 +57,15 @@ func gatherProjectReports(projectPath string) ([]reportEntry, error) {
 		all = append(all, entries...)
 	}
 
+	jobDir := filepath.Join(reportDir, jobReportDir)
+	if entries, err := collectReportFiles(jobDir, projectPath, "job", jobReportTypeFromPath); err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	} else {
+		all = append(all, entries...)
+	}
+
 	verifyDir := filepath.Join(projectPath, ".gpt-creator", "staging", "verify")
 	if entries, err := collectReportFiles(verifyDir, projectPath, "verify", verifyReportTypeFromPath); err != nil {
 		if !errors.Is(err, fs.ErrNotExist) {
@@ -189,11 +198,19 @@ func collectReportFiles(dir, projectPath, source string, typeResolver func(base,
 		".html":     {},
 		".htm":      {},
 	}
+	// Plain text is only a report format for captured job output; the
+	// job-output directory is collected on its own under the "job" source.
+	if source == "job" {
+		allowedExt[".txt"] = struct{}{}
+	}
 	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil
 		}
 		if d.IsDir() {
+			if source == "report" && path == filepath.Join(dir, jobReportDir) {
+				return filepath.SkipDir
+			}
 			return nil
 		}
 		ext := strings.ToLower(filepath.Ext(d.Name()))
@@ -436,3 +453,67 @@ func titleCase(input string) string {
 	}
 	return strings.Join(parts, " ")
 }
+
+// jobReportDir is where captured job output is written, under reports/ so
+// the Reports view lists it.
+const jobReportDir = "job-output"
+
+func jobReportTypeFromPath(base, rel string) string {
+	return "Job Output"
+}
+
+// jobReportSlug turns a job title into a file-name fragment.
+func jobReportSlug(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	slug := strings.TrimSuffix(b.String(), "-")
+	if slug == "" {
+		slug = "job"
+	}
+	return slug
+}
+
+// writeJobOutputReport saves a finished job's output as a timestamped text
+// report and returns its path. The first line doubles as the report title.
+func writeJobOutputReport(projectPath string, status jobStatus, output []string) (string, error) {
+	dir := filepath.Join(projectPath, "reports", jobReportDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	stamp := status.Ended
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.txt", stamp.Format("20060102-150405"), jobReportSlug(status.Title)))
+	var b strings.Builder
+	fmt.Fprintf(&b, "Job output: %s\n\n", safeTitle(status.Title))
+	fmt.Fprintf(&b, "Status:   %s\n", status.Status)
+	if status.Err != "" {
+		fmt.Fprintf(&b, "Error:    %s\n", status.Err)
+	}
+	if !status.Started.IsZero() {
+		fmt.Fprintf(&b, "Started:  %s\n", status.Started.Format(time.RFC3339))
+	}
+	fmt.Fprintf(&b, "Finished: %s\n", stamp.Format(time.RFC3339))
+	if !status.Started.IsZero() && !status.Ended.IsZero() {
+		fmt.Fprintf(&b, "Duration: %s\n", formatElapsed(status.Ended.Sub(status.Started)))
+	}
+	b.WriteString("\n")
+	for _, line := range output {
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	if err := os.WriteFile(path, []byte(b.String()), 0o644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -37,7 +37,7 @@ type styles struct {
 	breadcrumbs                                        lipgloss.Style
 	statusBar, statusSeg, statusHint                   lipgloss.Style
 	logDebug                                           lipgloss.Style
-	logSelection                                       lipgloss.Style
+	logSelection, logMatch                             lipgloss.Style
 	tableHeader, tableCell, tableActive                lipgloss.Style
 	listItem, listSel, textBlock                       lipgloss.Style
 	rightPaneTitle                                     lipgloss.Style
@@ -202,6 +202,9 @@ func newStyles() styles {
 		logSelection: base.Copy().
 			Background(crushSurfaceElevated).
 			Bold(true),
+		logMatch: base.Copy().
+			Foreground(crushAccent).
+			Underline(true),
 		tableHeader: base.Copy().
 			Foreground(crushPrimaryBright).
 			Background(crushSurfaceSoft).
@@ -316,6 +319,91 @@ func newStyles() styles {
 	}
 }
 
+var (
+	contrastBackground = lipgloss.Color("#000000")
+	contrastForeground = lipgloss.Color("#FFFFFF")
+	contrastHighlight  = lipgloss.Color("#FFFF00")
+	contrastAccent     = lipgloss.Color("#00FFFF")
+)
+
+// stylesForTheme returns the crush palette, or the high-contrast variant when
+// that theme is selected.
+func stylesForTheme(theme markdownTheme) styles {
+	if theme == markdownThemeHighContrast {
+		return highContrastStyles()
+	}
+	return newStyles()
+}
+
+// highContrastStyles keeps the crush layout but renders on pure black with
+// white text and borders, and marks selection and focus in yellow.
+func highContrastStyles() styles {
+	s := newStyles()
+	plain := func(style lipgloss.Style) lipgloss.Style {
+		return style.Copy().
+			Foreground(contrastForeground).
+			Background(contrastBackground).
+			BorderForeground(contrastForeground).
+			Faint(false)
+	}
+	inverted := func(style lipgloss.Style) lipgloss.Style {
+		return style.Copy().
+			Foreground(contrastBackground).
+			Background(contrastHighlight).
+			BorderForeground(contrastHighlight).
+			Bold(true)
+	}
+
+	s.app = plain(s.app)
+	s.topBar = plain(s.topBar)
+	s.topMenu = plain(s.topMenu).Bold(true)
+	s.topStatus = plain(s.topStatus)
+	s.headerLogo = plain(s.headerLogo).Foreground(contrastHighlight)
+	s.headerBreadcrumb = plain(s.headerBreadcrumb)
+	s.headerSearch = plain(s.headerSearch)
+	s.headerSearchHint = plain(s.headerSearchHint)
+	s.sidebar = plain(s.sidebar)
+	s.sidebarTitle = plain(s.sidebarTitle).Foreground(contrastHighlight)
+	s.columnTitle = plain(s.columnTitle).BorderForeground(contrastHighlight)
+	s.body = plain(s.body)
+	s.panel = plain(s.panel)
+	s.panelFocused = plain(s.panelFocused).BorderForeground(contrastHighlight).BorderStyle(lipgloss.ThickBorder())
+	s.tabActive = inverted(s.tabActive)
+	s.tabInactive = plain(s.tabInactive)
+	s.tabsRow = plain(s.tabsRow)
+	s.breadcrumbs = plain(s.breadcrumbs)
+	s.statusBar = plain(s.statusBar)
+	s.statusSeg = plain(s.statusSeg).Bold(true)
+	s.statusHint = plain(s.statusHint)
+	s.logDebug = plain(s.logDebug).Foreground(contrastAccent)
+	s.logSelection = inverted(s.logSelection)
+	s.logMatch = plain(s.logMatch).Foreground(contrastHighlight)
+	s.tableHeader = plain(s.tableHeader).Foreground(contrastHighlight)
+	s.tableCell = plain(s.tableCell)
+	s.tableActive = inverted(s.tableActive)
+	s.listItem = plain(s.listItem)
+	s.listSel = inverted(s.listSel)
+	s.textBlock = plain(s.textBlock)
+	s.rightPaneTitle = plain(s.rightPaneTitle).Foreground(contrastHighlight)
+	s.cmdOverlay = plain(s.cmdOverlay).BorderForeground(contrastHighlight)
+	s.cmdPrompt = plain(s.cmdPrompt).Foreground(contrastHighlight)
+	s.cmdHint = plain(s.cmdHint)
+	s.cmdCloseButton = inverted(s.cmdCloseButton)
+	s.confirmMessage = plain(s.confirmMessage)
+	s.confirmButton = plain(s.confirmButton)
+	s.confirmButtonActive = inverted(s.confirmButtonActive)
+	s.chatHeader = plain(s.chatHeader).Foreground(contrastHighlight)
+	s.chatUserLabel = plain(s.chatUserLabel).Foreground(contrastHighlight)
+	s.chatAssistantLabel = plain(s.chatAssistantLabel).Foreground(contrastAccent)
+	s.chatSystemLabel = plain(s.chatSystemLabel)
+	s.chatTimestamp = plain(s.chatTimestamp)
+	s.chatUserBubble = plain(s.chatUserBubble)
+	s.chatAssistantBubble = plain(s.chatAssistantBubble)
+	s.chatSystemBubble = plain(s.chatSystemBubble)
+	s.chatHint = plain(s.chatHint)
+	return s
+}
+
 func (s styles) renderText(width int, content string) string {
 	if width < 1 {
 		width = 1
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -23,13 +24,25 @@ type telemetryEvent struct {
 	ExtraJSON map[string]string `json:"extra_json,omitempty"`
 }
 
+const (
+	defaultTelemetryMaxBytes    = 5 << 20
+	telemetryRotatedGenerations = 2
+	telemetryViewerLimit        = 500
+)
+
 type telemetryLogger struct {
 	path      string
 	sessionID string
 	userID    string
+	disabled  bool
+	maxBytes  int64
 	mu        sync.Mutex
 }
 
+func telemetryLogPath() string {
+	return filepath.Join(resolveStateDir(), "ui-events.ndjson")
+}
+
 func newTelemetryLogger(path, sessionID, userID string) *telemetryLogger {
 	dir := filepath.Dir(path)
 	_ = os.MkdirAll(dir, 0o755)
@@ -37,9 +50,34 @@ func newTelemetryLogger(path, sessionID, userID string) *telemetryLogger {
 		path:      path,
 		sessionID: strings.TrimSpace(sessionID),
 		userID:    strings.TrimSpace(userID),
+		maxBytes:  defaultTelemetryMaxBytes,
 	}
 }
 
+// SetMaxBytes sets the size at which the event log is rotated. Values <= 0
+// restore the default limit.
+func (t *telemetryLogger) SetMaxBytes(limit int64) {
+	if t == nil {
+		return
+	}
+	if limit <= 0 {
+		limit = defaultTelemetryMaxBytes
+	}
+	t.mu.Lock()
+	t.maxBytes = limit
+	t.mu.Unlock()
+}
+
+// SetEnabled turns event logging on or off for the rest of the session.
+func (t *telemetryLogger) SetEnabled(enabled bool) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	t.disabled = !enabled
+	t.mu.Unlock()
+}
+
 func (t *telemetryLogger) Emit(event telemetryEvent) {
 	if t == nil || strings.TrimSpace(event.Event) == "" {
 		return
@@ -61,12 +99,16 @@ func (t *telemetryLogger) Emit(event telemetryEvent) {
 
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	if t.disabled {
+		return
+	}
 
 	data, err := json.Marshal(event)
 	if err != nil {
 		return
 	}
 	data = append(data, '\n')
+	t.rotateIfNeeded(int64(len(data)))
 	f, err := os.OpenFile(t.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
 	if err != nil {
 		return
@@ -75,6 +117,78 @@ func (t *telemetryLogger) Emit(event telemetryEvent) {
 	_, _ = f.Write(data)
 }
 
+// Clear truncates the event log. Rotated generations are left untouched.
+func (t *telemetryLogger) Clear() error {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err := os.Truncate(t.path, 0); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// readTelemetryEvents returns up to limit of the most recent events recorded
+// in path, newest first. Lines that fail to parse are skipped.
+func readTelemetryEvents(path string, limit int) ([]telemetryEvent, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	var events []telemetryEvent
+	for i := len(lines) - 1; i >= 0; i-- {
+		if limit > 0 && len(events) >= limit {
+			break
+		}
+		line := strings.TrimSpace(lines[i])
+		if line == "" {
+			continue
+		}
+		var event telemetryEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil || strings.TrimSpace(event.Event) == "" {
+			continue
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// telemetryEventDetails flattens the interesting fields of an event into a
+// single key=value line for table display and filtering.
+func telemetryEventDetails(event telemetryEvent) string {
+	var parts []string
+	if event.Project != "" {
+		parts = append(parts, "project="+filepath.Base(event.Project))
+	}
+	if event.Feature != "" {
+		parts = append(parts, "feature="+event.Feature)
+	}
+	if event.ItemID != "" {
+		parts = append(parts, "item="+event.ItemID)
+	}
+	keys := make([]string, 0, len(event.ExtraJSON))
+	for key := range event.ExtraJSON {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		parts = append(parts, key+"="+event.ExtraJSON[key])
+	}
+	return strings.Join(parts, " ")
+}
+
+func telemetryEventMatches(event telemetryEvent, filter string) bool {
+	needle := strings.ToLower(strings.TrimSpace(filter))
+	if needle == "" {
+		return true
+	}
+	haystack := strings.ToLower(event.Event + " " + event.Project + " " + telemetryEventDetails(event))
+	return strings.Contains(haystack, needle)
+}
+
 func newTelemetrySessionID() string {
 	buf := make([]byte, 16)
 	if _, err := rand.Read(buf); err == nil {
@@ -97,3 +211,24 @@ func resolveTelemetryUserID() string {
 	}
 	return ""
 }
+
+// rotateIfNeeded shifts the event log to numbered generations once appending
+// pending bytes would exceed the size limit. Callers must hold t.mu.
+func (t *telemetryLogger) rotateIfNeeded(pending int64) {
+	if t.maxBytes <= 0 {
+		return
+	}
+	info, err := os.Stat(t.path)
+	if err != nil || info.Size() == 0 || info.Size()+pending <= t.maxBytes {
+		return
+	}
+	oldest := fmt.Sprintf("%s.%d", t.path, telemetryRotatedGenerations)
+	_ = os.Remove(oldest)
+	for gen := telemetryRotatedGenerations - 1; gen >= 1; gen-- {
+		src := fmt.Sprintf("%s.%d", t.path, gen)
+		if _, err := os.Stat(src); err == nil {
+			_ = os.Rename(src, fmt.Sprintf("%s.%d", t.path, gen+1))
+		}
+	}
+	_ = os.Rename(t.path, t.path+".1")
+}
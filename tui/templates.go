@@ -0,0 +1,99 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// projectTemplate is a directory under the CLI's project_templates/ folder.
+type projectTemplate struct {
+	Name string
+	Tags []string
+}
+
+// projectTemplatesDir locates project_templates/ beside the installed CLI
+// (bin/gpt-creator → <root>/project_templates). The CLI has no flag to list
+// templates, so the directory is read directly.
+func projectTemplatesDir() string {
+	bin, err := exec.LookPath("gpt-creator")
+	if err != nil {
+		return ""
+	}
+	if resolved, err := filepath.EvalSymlinks(bin); err == nil {
+		bin = resolved
+	}
+	dir := filepath.Join(filepath.Dir(filepath.Dir(bin)), "project_templates")
+	if !dirExists(dir) {
+		return ""
+	}
+	return dir
+}
+
+func discoverProjectTemplates(dir string) []projectTemplate {
+	if dir == "" {
+		return nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var templates []projectTemplate
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || strings.HasPrefix(name, ".") {
+			continue
+		}
+		templates = append(templates, projectTemplate{
+			Name: name,
+			Tags: readTemplateTags(filepath.Join(dir, name)),
+		})
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates
+}
+
+// readTemplateTags reads tags.txt (newline or comma separated) or the tags
+// field of template.json, mirroring the hints the CLI uses for auto matching.
+func readTemplateTags(dir string) []string {
+	var tags []string
+	if data, err := os.ReadFile(filepath.Join(dir, "tags.txt")); err == nil {
+		for _, field := range strings.FieldsFunc(string(data), func(r rune) bool { return r == ',' || r == '\n' }) {
+			if tag := strings.TrimSpace(field); tag != "" && !strings.HasPrefix(tag, "#") {
+				tags = append(tags, tag)
+			}
+		}
+		return tags
+	}
+	if data, err := os.ReadFile(filepath.Join(dir, "template.json")); err == nil {
+		var meta struct {
+			Tags []string `json:"tags"`
+		}
+		if json.Unmarshal(data, &meta) == nil {
+			tags = meta.Tags
+		}
+	}
+	return tags
+}
+
+func templatePaletteEntries(templates []projectTemplate) []paletteEntry {
+	entries := []paletteEntry{
+		{label: "auto", description: "Match a template to the staged RFP/PDR", meta: map[string]string{"template": "auto"}},
+		{label: "skip", description: "Start without a template", meta: map[string]string{"template": "skip"}},
+	}
+	for _, tpl := range templates {
+		desc := "project template"
+		if len(tpl.Tags) > 0 {
+			desc = strings.Join(tpl.Tags, ", ")
+		}
+		entries = append(entries, paletteEntry{
+			label:       tpl.Name,
+			description: desc,
+			meta:        map[string]string{"template": tpl.Name},
+		})
+	}
+	return entries
+}
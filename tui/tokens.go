@@ -18,6 +18,7 @@ import (
 const (
 	defaultTokensCostPerThousand = 0.002
 	maxTokensPreviewRecords      = 24
+	tokensUtilizationWarning     = 0.9
 )
 
 type tokensRangeOption struct {
@@ -52,6 +53,7 @@ type tokenLogRecord struct {
 	CachedTokens     int
 	BillableUnits    int
 	RequestUnits     int
+	ContextWindow    int
 	EstimatedCost    float64
 	ExitCode         *int
 	UsageCaptured    bool
@@ -105,6 +107,11 @@ type tokensViewSummary struct {
 	DistinctDays     int
 	TopCommands      []tokensBreakdown
 	Records          int
+	// MaxUtilization is the highest prompt-tokens / context-window ratio of
+	// any call with a known context size; zero when none is known.
+	MaxUtilization     float64
+	MaxUtilizationCall tokenLogRecord
+	OverUtilization    int
 }
 
 type tokensBreakdown struct {
@@ -214,6 +221,12 @@ func parseTokenLogRecord(line string) (tokenLogRecord, bool) {
 	rec.CachedTokens = parseUsageInt(payload["cached_tokens"])
 	rec.BillableUnits = parseUsageInt(payload["billable_units"])
 	rec.RequestUnits = parseUsageInt(payload["request_units"])
+	for _, field := range []string{"context_window", "max_context_tokens", "max_context"} {
+		if window := parseUsageInt(payload[field]); window > 0 {
+			rec.ContextWindow = window
+			break
+		}
+	}
 	if value, ok := payload["exit_code"]; ok {
 		if parsed := parseUsageInt(value); parsed != 0 {
 			rec.ExitCode = &parsed
@@ -318,7 +331,7 @@ func estimateTokensCost(totalTokens int) float64 {
 	return (float64(totalTokens) / 1000.0) * tokensCostPerThousand()
 }
 
-func buildTokensView(usage *tokensUsage, option tokensRangeOption, group tokensGroupMode) (tokensViewData, error) {
+func buildTokensView(usage *tokensUsage, option tokensRangeOption, group tokensGroupMode, contextWindows map[string]int) (tokensViewData, error) {
 	data := tokensViewData{
 		Range: option,
 		Group: group,
@@ -335,6 +348,7 @@ func buildTokensView(usage *tokensUsage, option tokensRangeOption, group tokensG
 	filtered, start, end := filterTokensRecords(usage, option)
 	data.Records = filtered
 	data.Summary = summarizeTokens(filtered, option, group, start, end)
+	summarizeContextUtilization(&data.Summary, filtered, contextWindows)
 	data.Rows = aggregateTokensRows(filtered, group)
 	return data, nil
 }
@@ -430,6 +444,49 @@ func summarizeTokens(records []tokenLogRecord, option tokensRangeOption, group t
 	return summary
 }
 
+// summarizeContextUtilization records the peak prompt/context ratio and how
+// many calls went past tokensUtilizationWarning. Calls without a recorded
+// context_window fall back to the configured per-model sizes.
+func summarizeContextUtilization(summary *tokensViewSummary, records []tokenLogRecord, contextWindows map[string]int) {
+	for _, rec := range records {
+		window := rec.ContextWindow
+		if window <= 0 {
+			window = lookupContextWindow(contextWindows, rec.Model)
+		}
+		if window <= 0 || rec.PromptTokens <= 0 {
+			continue
+		}
+		ratio := float64(rec.PromptTokens) / float64(window)
+		if ratio > tokensUtilizationWarning {
+			summary.OverUtilization++
+		}
+		if ratio > summary.MaxUtilization {
+			summary.MaxUtilization = ratio
+			summary.MaxUtilizationCall = rec
+		}
+	}
+}
+
+// lookupContextWindow matches model case-insensitively, falling back to the
+// longest configured name that prefixes it so "gpt-4o" covers dated variants.
+func lookupContextWindow(contextWindows map[string]int, model string) int {
+	model = strings.ToLower(strings.TrimSpace(model))
+	if model == "" || len(contextWindows) == 0 {
+		return 0
+	}
+	best, bestLen := 0, 0
+	for name, window := range contextWindows {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == model {
+			return window
+		}
+		if name != "" && strings.HasPrefix(model, name) && len(name) > bestLen {
+			best, bestLen = window, len(name)
+		}
+	}
+	return best
+}
+
 func aggregateTokensRows(records []tokenLogRecord, group tokensGroupMode) []tokensTableRow {
 	if len(records) == 0 {
 		return nil
@@ -725,6 +782,18 @@ func renderTokensPreview(data tokensViewData, row tokensTableRow) string {
 			formatCost(data.Summary.TotalCost),
 			data.Summary.DistinctCommands))
 	}
+	if data.Summary.MaxUtilization > 0 {
+		peak := data.Summary.MaxUtilizationCall
+		b.WriteString(fmt.Sprintf("Peak context use: %.0f%% (%s prompt tokens, %s, %s)\n",
+			data.Summary.MaxUtilization*100,
+			formatIntComma(peak.PromptTokens),
+			fallback(peak.Model, "unknown model"),
+			fallback(peak.Command, "(unknown)")))
+		if data.Summary.OverUtilization > 0 {
+			b.WriteString(fmt.Sprintf("⚠ %d call(s) used more than %.0f%% of the context window\n",
+				data.Summary.OverUtilization, tokensUtilizationWarning*100))
+		}
+	}
 
 	b.WriteString("\nSample NDJSON:\n")
 	limit := maxTokensPreviewRecords
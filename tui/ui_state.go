@@ -1,18 +1,44 @@
 package main
 
 import (
+	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
 
+const settingsExportName = "gpt-creator-ui.yaml"
+
 type uiConfig struct {
-	Pinned         []string `yaml:"pinned,omitempty"`
-	Theme          string   `yaml:"theme,omitempty"`
-	Concurrency    int      `yaml:"concurrency,omitempty"`
-	DockerPath     string   `yaml:"docker_path,omitempty"`
-	WorkspaceRoots []string `yaml:"workspace_roots,omitempty"`
+	Pinned           []string            `yaml:"pinned,omitempty"`
+	Theme            string              `yaml:"theme,omitempty"`
+	Concurrency      int                 `yaml:"concurrency,omitempty"`
+	ServicesPoll     *int                `yaml:"services_poll_seconds,omitempty"`
+	DashboardRefresh int                 `yaml:"dashboard_refresh_seconds,omitempty"`
+	LogsHeight       int                 `yaml:"logs_height,omitempty"`
+	LogLines         int                 `yaml:"log_lines,omitempty"`
+	ColumnWidths     map[string][]int    `yaml:"column_widths,omitempty"`
+	ColumnLayout     string              `yaml:"column_layout,omitempty"`
+	PreviewWrap      bool                `yaml:"preview_wrap,omitempty"`
+	LineNumbers      bool                `yaml:"line_numbers,omitempty"`
+	DocsRaw          bool                `yaml:"docs_raw,omitempty"`
+	Telemetry        *bool               `yaml:"telemetry,omitempty"`
+	TelemetryMaxMB   int                 `yaml:"telemetry_max_mb,omitempty"`
+	DryRun           bool                `yaml:"dry_run,omitempty"`
+	AutoWatch        bool                `yaml:"auto_watch,omitempty"`
+	AutoVerify       bool                `yaml:"auto_verify,omitempty"`
+	InlineImages     bool                `yaml:"inline_images,omitempty"`
+	DenseLists       bool                `yaml:"dense_lists,omitempty"`
+	ConfirmCommands  *[]string           `yaml:"confirm_commands,omitempty"`
+	DockerPath       string              `yaml:"docker_path,omitempty"`
+	WorkspaceRoots   []string            `yaml:"workspace_roots,omitempty"`
+	ModelContext     map[string]int      `yaml:"model_context_windows,omitempty"`
+	RecentFiles      map[string][]string `yaml:"recent_files,omitempty"`
+	HiddenFeatures   []string            `yaml:"hidden_features,omitempty"`
 }
 
 func loadUIConfig() (*uiConfig, string) {
@@ -43,6 +69,48 @@ func saveUIConfig(cfg *uiConfig, path string) error {
 	return os.WriteFile(path, data, 0o644)
 }
 
+func readUIConfigFile(path string) (*uiConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg uiConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// dropMissingPaths removes pins, workspace roots and a docker path that do
+// not exist on this machine, returning what was dropped.
+func (cfg *uiConfig) dropMissingPaths() []string {
+	var dropped []string
+	keepDirs := func(paths []string) []string {
+		var kept []string
+		for _, path := range paths {
+			clean := filepath.Clean(strings.TrimSpace(path))
+			if clean == "" || clean == "." {
+				continue
+			}
+			if !dirExists(clean) {
+				dropped = append(dropped, clean)
+				continue
+			}
+			kept = append(kept, clean)
+		}
+		return kept
+	}
+	cfg.Pinned = keepDirs(cfg.Pinned)
+	cfg.WorkspaceRoots = keepDirs(cfg.WorkspaceRoots)
+	if docker := strings.TrimSpace(cfg.DockerPath); docker != "" {
+		if _, err := exec.LookPath(docker); err != nil {
+			dropped = append(dropped, docker)
+			cfg.DockerPath = ""
+		}
+	}
+	return dropped
+}
+
 func resolveConfigDir() string {
 	dir, err := os.UserConfigDir()
 	if err != nil {
@@ -50,3 +118,77 @@ func resolveConfigDir() string {
 	}
 	return filepath.Join(dir, "gpt-creator")
 }
+
+// resolveStateDir is where append-only state such as the telemetry log lives.
+// It follows XDG_STATE_HOME, then ~/.local/state on Unix and %LocalAppData%
+// on Windows. macOS keeps state beside the config in Application Support.
+func resolveStateDir() string {
+	if dir := strings.TrimSpace(os.Getenv("XDG_STATE_HOME")); dir != "" && filepath.IsAbs(dir) {
+		return filepath.Join(dir, "gpt-creator")
+	}
+	switch runtime.GOOS {
+	case "darwin":
+		return resolveConfigDir()
+	case "windows":
+		if dir := strings.TrimSpace(os.Getenv("LocalAppData")); dir != "" {
+			return filepath.Join(dir, "gpt-creator")
+		}
+	default:
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, ".local", "state", "gpt-creator")
+		}
+	}
+	return resolveConfigDir()
+}
+
+// migrateStateFiles moves state files written by older versions from the
+// config dir into the state dir. Files already present in the state dir are
+// left alone, so the move only happens once.
+func migrateStateFiles() error {
+	from, to := resolveConfigDir(), resolveStateDir()
+	if filepath.Clean(from) == filepath.Clean(to) {
+		return nil
+	}
+	matches, err := filepath.Glob(filepath.Join(from, "ui-events.ndjson*"))
+	if err != nil || len(matches) == 0 {
+		return err
+	}
+	if err := ensureDir(to); err != nil {
+		return err
+	}
+	for _, src := range matches {
+		dst := filepath.Join(to, filepath.Base(src))
+		if _, err := os.Stat(dst); err == nil {
+			continue
+		}
+		if err := moveFile(src, dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveFile renames src to dst, copying when they sit on different devices.
+func moveFile(src, dst string) error {
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
@@ -161,6 +161,51 @@ func verifyStatusLabel(status string) string {
 	}
 }
 
+// verifyCheckDetailsText formats a verify check item's metadata as plain
+// text for pasting into a ticket. Relative report and log paths are resolved
+// against the project so they can be opened from anywhere.
+func verifyCheckDetailsText(projectPath string, meta map[string]string) string {
+	name := strings.TrimSpace(meta["verifyName"])
+	if name == "" {
+		return ""
+	}
+	resolve := func(path string) string {
+		path = strings.TrimSpace(path)
+		if path == "" || filepath.IsAbs(path) || projectPath == "" {
+			return path
+		}
+		return filepath.Join(projectPath, path)
+	}
+	var b strings.Builder
+	label := strings.TrimSpace(meta["verifyLabel"])
+	if label != "" && label != name {
+		fmt.Fprintf(&b, "Verify check: %s (%s)\n", label, name)
+	} else {
+		fmt.Fprintf(&b, "Verify check: %s\n", name)
+	}
+	fmt.Fprintf(&b, "Status: %s\n", verifyStatusLabel(meta["verifyStatus"]))
+	duration := meta["verifyDuration"]
+	if duration != "" {
+		duration += "s"
+	}
+	rows := [][2]string{
+		{"Message", meta["verifyMessage"]},
+		{"Score", meta["verifyScore"]},
+		{"Run", meta["verifyRunKind"]},
+		{"Updated", meta["verifyUpdated"]},
+		{"Duration", duration},
+		{"Report", resolve(meta["verifyReport"])},
+		{"Log", resolve(meta["verifyLog"])},
+		{"Project", projectPath},
+	}
+	for _, row := range rows {
+		if value := strings.TrimSpace(row[1]); value != "" {
+			fmt.Fprintf(&b, "%s: %s\n", row[0], value)
+		}
+	}
+	return b.String()
+}
+
 func overallVerifyStatus(summary verifySummary) string {
 	if summary.Stats.Total == 0 {
 		return "pending"
@@ -315,6 +360,16 @@ func verifySummaryForProject(project *discoveredProject) verifySummary {
 	return loadVerifySummary(project.Path)
 }
 
+func failedVerifyChecks(summary verifySummary) []verifyCheck {
+	var failed []verifyCheck
+	for _, check := range sortedVerifyChecks(summary) {
+		if normalizeVerifyStatus(check.Status) == "fail" {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
+
 func formatVerifyDuration(seconds float64) string {
 	if seconds <= 0 {
 		return ""
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/fsnotify/fsnotify"
+)
+
+const (
+	rootWatchDebounce = 750 * time.Millisecond
+	fileWatchDebounce = 300 * time.Millisecond
+)
+
+type rootChangedMsg struct {
+	root string
+}
+
+type fileChangedMsg struct {
+	path string
+}
+
+// rootWatcher reports directories created or removed directly under a
+// workspace root. Bursts of events are debounced into one rootChangedMsg.
+type rootWatcher struct {
+	root    string
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+}
+
+func startRootWatcher(root string, out chan<- rootChangedMsg) (*rootWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	root = filepath.Clean(root)
+	if err := watcher.Add(root); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+	w := &rootWatcher{root: root, watcher: watcher, done: make(chan struct{})}
+	go w.run(out)
+	return w, nil
+}
+
+func (w *rootWatcher) run(out chan<- rootChangedMsg) {
+	debounceEvents(w.watcher, w.done, rootWatchDebounce, rootWatchRelevant, func() bool {
+		select {
+		case out <- rootChangedMsg{root: w.root}:
+			return true
+		case <-w.done:
+			return false
+		}
+	})
+}
+
+// debounceEvents calls emit once a burst of relevant events has been quiet
+// for delay. It returns when done closes, the watcher closes, or emit
+// reports false.
+func debounceEvents(watcher *fsnotify.Watcher, done <-chan struct{}, delay time.Duration, relevant func(fsnotify.Event) bool, emit func() bool) {
+	var (
+		timer *time.Timer
+		fire  <-chan time.Time
+	)
+	for {
+		select {
+		case <-done:
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if !relevant(event) {
+				continue
+			}
+			if timer == nil {
+				timer = time.NewTimer(delay)
+			} else {
+				if !timer.Stop() && fire != nil {
+					<-timer.C
+				}
+				timer.Reset(delay)
+			}
+			fire = timer.C
+		case _, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+		case <-fire:
+			fire = nil
+			if !emit() {
+				return
+			}
+		}
+	}
+}
+
+// rootWatchRelevant keeps directory creations plus removals and renames,
+// which can no longer be inspected and may have been directories.
+func rootWatchRelevant(event fsnotify.Event) bool {
+	switch {
+	case event.Has(fsnotify.Remove), event.Has(fsnotify.Rename):
+		return true
+	case event.Has(fsnotify.Create):
+		info, err := os.Stat(event.Name)
+		return err == nil && info.IsDir()
+	default:
+		return false
+	}
+}
+
+func (w *rootWatcher) Stop() {
+	close(w.done)
+	w.watcher.Close()
+}
+
+func waitForRootChange(ch <-chan rootChangedMsg) tea.Cmd {
+	return func() tea.Msg {
+		return <-ch
+	}
+}
+
+// fileWatcher reports writes to a single file. It watches the parent
+// directory so files replaced by rename (as most generators and editors do)
+// keep being followed.
+type fileWatcher struct {
+	path    string
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+}
+
+func startFileWatcher(path string, out chan<- fileChangedMsg) (*fileWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	path = filepath.Clean(path)
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+	w := &fileWatcher{path: path, watcher: watcher, done: make(chan struct{})}
+	go w.run(out)
+	return w, nil
+}
+
+func (w *fileWatcher) run(out chan<- fileChangedMsg) {
+	relevant := func(event fsnotify.Event) bool {
+		return filepath.Clean(event.Name) == w.path && (event.Has(fsnotify.Write) || event.Has(fsnotify.Create))
+	}
+	debounceEvents(w.watcher, w.done, fileWatchDebounce, relevant, func() bool {
+		select {
+		case out <- fileChangedMsg{path: w.path}:
+			return true
+		case <-w.done:
+			return false
+		}
+	})
+}
+
+func (w *fileWatcher) Stop() {
+	close(w.done)
+	w.watcher.Close()
+}
+
+func waitForFileChange(ch <-chan fileChangedMsg) tea.Cmd {
+	return func() tea.Msg {
+		return <-ch
+	}
+}